@@ -1,7 +1,71 @@
 package bills
 
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
 type ActionDate struct {
 	HumanReadable   string `xml:",chardata"`
 	EventDate       *Date  `xml:"date,attr"`
 	LegislativeDate *Date  `xml:"legis-day,attr"`
 }
+
+// legislativeDayRe matches the parenthetical that gives the legislative day
+// when it differs from the calendar day, as in
+// "January 5 (legislative day, January 3), 2017".
+var legislativeDayRe = regexp.MustCompile(`\s*\(legislative day,?\s*([^)]*)\)`)
+
+// ParseHumanReadable parses the HumanReadable text of the action date,
+// returning the event date and, if the text includes one, the legislative
+// day.
+func (d *ActionDate) ParseHumanReadable() (event Date, legislative *Date, err error) {
+	text := strings.TrimSpace(d.HumanReadable)
+
+	var legisText string
+	if m := legislativeDayRe.FindStringSubmatchIndex(text); m != nil {
+		legisText = strings.TrimSpace(text[m[2]:m[3]])
+		text = text[:m[0]] + text[m[1]:]
+	}
+
+	event, err = ParseHumanDate(text)
+	if err != nil {
+		return Date{}, nil, err
+	}
+
+	if legisText != "" {
+		// The legislative day often omits the year when it is the same as
+		// that of the event date.
+		ld, err := ParseHumanDate(legisText)
+		if err != nil {
+			ld, err = ParseHumanDate(fmt.Sprintf("%s, %d", legisText, event.Year))
+		}
+		if err != nil {
+			return Date{}, nil, fmt.Errorf("invalid legislative day %q", legisText)
+		}
+		legislative = &ld
+	}
+
+	return event, legislative, nil
+}
+
+// Check verifies that the HumanReadable text of the action date agrees with
+// its date attributes, returning an error describing the first
+// disagreement if not.
+//
+// Attributes that are not present are not checked.
+func (d *ActionDate) Check() error {
+	event, legislative, err := d.ParseHumanReadable()
+	if err != nil {
+		return err
+	}
+
+	if d.EventDate != nil && *d.EventDate != event {
+		return fmt.Errorf("text gives date %s but date attribute gives %s", event, *d.EventDate)
+	}
+	if d.LegislativeDate != nil && legislative != nil && *d.LegislativeDate != *legislative {
+		return fmt.Errorf("text gives legislative day %s but legis-day attribute gives %s", *legislative, *d.LegislativeDate)
+	}
+	return nil
+}
@@ -0,0 +1,230 @@
+package bills
+
+import (
+	"encoding/xml"
+)
+
+// Amendment represents an amendment document, such as an engrossed
+// amendment from the second chamber or an amendment submitted for
+// consideration on the floor.
+type Amendment struct {
+	Type          AmendmentType `xml:"amend-type,attr"`
+	Stage         BillStage     `xml:"amend-stage,attr"`
+	DMSId         string        `xml:"dms-id,attr"`
+	PublicPrivate PublicPrivate `xml:"public-private,attr"`
+
+	Metadata *Metadata
+	Form     *AmendmentForm
+	Body     *AmendmentBody
+
+	Attestation *Attestation
+	Endorsement *Endorsement
+}
+
+func (a *Amendment) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	*a = Amendment{}
+	err := decodeXMLAttrs(a, start)
+	if err != nil {
+		return err
+	}
+
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+
+		switch t := token.(type) {
+		case xml.EndElement:
+			// all done!
+			return nil
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "metadata":
+				a.Metadata = &Metadata{}
+				err := d.DecodeElement(a.Metadata, &t)
+				if err != nil {
+					return err
+				}
+			case "amendment-form", "engrossed-amendment-form":
+				a.Form = &AmendmentForm{}
+				err := d.DecodeElement(a.Form, &t)
+				if err != nil {
+					return err
+				}
+			case "amendment-body", "engrossed-amendment-body":
+				a.Body = &AmendmentBody{}
+				err := d.DecodeElement(a.Body, &t)
+				if err != nil {
+					return err
+				}
+			case "attestation":
+				a.Attestation = &Attestation{}
+				err := d.DecodeElement(a.Attestation, &t)
+				if err != nil {
+					return err
+				}
+			case "endorsement":
+				a.Endorsement = &Endorsement{}
+				err := d.DecodeElement(a.Endorsement, &t)
+				if err != nil {
+					return err
+				}
+			default:
+				err := d.Skip()
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// AmendmentType is the value of the "amend-type" attribute on an
+// amendment's root element.
+type AmendmentType string
+
+const (
+	EngrossedAmendment AmendmentType = "engrossed-amendment"
+	HouseAmendment     AmendmentType = "house-amendment"
+	SenateAmendment    AmendmentType = "senate-amendment"
+)
+
+func (t AmendmentType) String() string {
+	return string(t)
+}
+
+func (t AmendmentType) Known() bool {
+	switch t {
+	case EngrossedAmendment, HouseAmendment, SenateAmendment:
+		return true
+	default:
+		return false
+	}
+}
+
+// AmendmentForm represents the cover page information of an amendment,
+// which has the same elements as the form of a bill along with some
+// additional elements that are specific to amendments.
+type AmendmentForm struct {
+	Form
+	AmendmentNumber string       `xml:"amend-num"`
+	Purpose         InlineMarkup `xml:"purpose"`
+}
+
+// AmendmentBody represents the body of an amendment.
+//
+// Amendment bodies have a mixed content model. Content elements are either
+// *AmendmentInstruction or *AmendmentBlock values, or implementations of
+// Block or Structural. The "amendment" elements that group instructions
+// together with their blocks are not represented, and their children
+// appear directly in Content instead.
+type AmendmentBody struct {
+	StyleCode Style `xml:"style,attr"`
+	Content   []interface{}
+}
+
+func (n *AmendmentBody) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	*n = AmendmentBody{}
+	err := decodeXMLAttrs(n, start)
+	if err != nil {
+		return err
+	}
+	return n.decodeContent(d)
+}
+
+func (n *AmendmentBody) decodeContent(d *xml.Decoder) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+
+		switch t := token.(type) {
+		case xml.EndElement:
+			// all done!
+			return nil
+		case xml.StartElement:
+			switch {
+			case t.Name.Local == "amendment":
+				err := n.decodeContent(d)
+				if err != nil {
+					return err
+				}
+			case t.Name.Local == "amendment-instruction":
+				obj := &AmendmentInstruction{}
+				err := d.DecodeElement(obj, &t)
+				if err != nil {
+					return err
+				}
+				n.Content = append(n.Content, obj)
+			case t.Name.Local == "amendment-block":
+				obj := &AmendmentBlock{}
+				err := d.DecodeElement(obj, &t)
+				if err != nil {
+					return err
+				}
+				n.Content = append(n.Content, obj)
+			case isBlockElement(t.Name):
+				obj, err := decodeBlockElement(d, t)
+				if err != nil {
+					return err
+				}
+				n.Content = append(n.Content, obj)
+			default:
+				obj, err := decodeStructuralElement(d, t)
+				if err != nil {
+					return err
+				}
+				n.Content = append(n.Content, obj)
+			}
+		}
+	}
+}
+
+// AmendmentInstruction represents an instruction describing how the
+// legislation under consideration is to be changed, such as "Page 3,
+// line 5, strike 'and' and insert the following:".
+type AmendmentInstruction struct {
+	Text InlineMarkup `xml:"text"`
+}
+
+// AmendmentBlock represents the text to be inserted by a preceding
+// amendment instruction.
+//
+// Amendment blocks use the same mixed content model as QuotedBlock.
+type AmendmentBlock struct {
+	Id        string `xml:"id,attr"`
+	StyleCode Style  `xml:"style,attr"`
+
+	// Content elements can either be implementations of Block or Structural
+	// or they can be InlineMarkup values representing paragraphs of text.
+	Content []interface{}
+}
+
+func (n *AmendmentBlock) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	*n = AmendmentBlock{}
+	err := decodeXMLAttrs(n, start)
+	if err != nil {
+		return err
+	}
+
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+
+		switch t := token.(type) {
+		case xml.EndElement:
+			// all done!
+			return nil
+		case xml.StartElement:
+			obj, err := decodeQuotedContent(d, t)
+			if err != nil {
+				return err
+			}
+			n.Content = append(n.Content, obj)
+		}
+	}
+}
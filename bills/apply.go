@@ -0,0 +1,359 @@
+package bills
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// ApplyFunc is the type of the pre and post functions passed to the Apply
+// methods of StructuralMarkup, BlockMarkup and InlineMarkup.
+type ApplyFunc func(*ApplyCursor) bool
+
+// ApplyCursor describes the node currently being visited by Apply, and
+// provides the operations for rewriting it.
+type ApplyCursor struct {
+	node   interface{}
+	parent interface{}
+	index  int
+
+	list    string
+	accepts func(interface{}) bool
+	deleted bool
+	before  []interface{}
+	after   []interface{}
+}
+
+// Node returns the current node, which is a Structural, a Block, an Inline
+// (including Text) or, for paragraphs of text directly within a quoted
+// block, an InlineMarkup.
+//
+// If the node was replaced by an earlier call to Replace, the replacement
+// is returned.
+func (c *ApplyCursor) Node() interface{} {
+	return c.node
+}
+
+// Parent returns the node whose content contains the current node, or nil
+// if the current node is at the top of the markup passed to Apply.
+//
+// Since Apply produces a new tree, the parent is the copy of the original
+// parent that will appear in the result, and its content is incomplete
+// until Apply has finished with it.
+func (c *ApplyCursor) Parent() interface{} {
+	return c.parent
+}
+
+// Index returns the position of the current node within the original list
+// that contains it.
+func (c *ApplyCursor) Index() int {
+	return c.index
+}
+
+// Replace replaces the current node with the given node.
+//
+// When called from the pre function, the content of the replacement is
+// traversed instead of the content of the original node.
+//
+// Replace panics if the given node cannot appear in the list containing
+// the current node, such as if a Block is given to replace an Inline.
+func (c *ApplyCursor) Replace(n interface{}) {
+	c.check(n)
+	c.node = n
+	c.deleted = false
+}
+
+// Delete removes the current node. When called from the pre function, its
+// content is not traversed and the post function is not called for it.
+func (c *ApplyCursor) Delete() {
+	c.deleted = true
+}
+
+// InsertBefore inserts the given node before the current node. The new
+// node is not traversed.
+//
+// InsertBefore panics if the given node cannot appear in the list
+// containing the current node.
+func (c *ApplyCursor) InsertBefore(n interface{}) {
+	c.check(n)
+	c.before = append(c.before, n)
+}
+
+// InsertAfter inserts the given node after the current node, and after
+// any nodes already inserted after it. The new node is not traversed.
+//
+// InsertAfter panics if the given node cannot appear in the list
+// containing the current node.
+func (c *ApplyCursor) InsertAfter(n interface{}) {
+	c.check(n)
+	c.after = append(c.after, n)
+}
+
+func (c *ApplyCursor) check(n interface{}) {
+	if !c.accepts(n) {
+		panic(fmt.Sprintf("bills: %T cannot appear in %s", n, c.list))
+	}
+}
+
+// Apply traverses the receiver and all of the markup within it, calling pre
+// before visiting the content of each node and post afterwards, and returns
+// a new tree reflecting any changes made through the ApplyCursor.
+//
+// If pre returns false, the content of the node is not traversed and post
+// is not called for it. If post returns false, the traversal stops and the
+// remainder of the tree is left as it was. Either function may be nil.
+//
+// A list whose nodes are all deleted becomes nil in the result, as if the
+// content had been absent from the source document.
+//
+// The receiver is not modified, but nodes that have no content to traverse
+// are shared between the receiver and the result. Structural and inline
+// elements of types defined in other packages are treated as if they have
+// no content, as are graphics, formulas and tables of contents.
+func (m StructuralMarkup) Apply(pre, post ApplyFunc) StructuralMarkup {
+	a := &applier{pre: pre, post: post}
+	return a.structuralMarkup(nil, m)
+}
+
+// Apply is like StructuralMarkup.Apply, but for block markup.
+func (m BlockMarkup) Apply(pre, post ApplyFunc) BlockMarkup {
+	a := &applier{pre: pre, post: post}
+	return a.blockMarkup(nil, m)
+}
+
+// Apply is like StructuralMarkup.Apply, but for inline markup.
+func (m InlineMarkup) Apply(pre, post ApplyFunc) InlineMarkup {
+	a := &applier{pre: pre, post: post}
+	return a.inlineMarkup(nil, m)
+}
+
+type applier struct {
+	pre, post ApplyFunc
+	stopped   bool
+}
+
+func (a *applier) structuralMarkup(parent interface{}, m StructuralMarkup) StructuralMarkup {
+	return applyList(a, parent, m, "structural markup", isA[Structural], a.structural)
+}
+
+func (a *applier) blockMarkup(parent interface{}, m BlockMarkup) BlockMarkup {
+	return applyList(a, parent, m, "block markup", isA[Block], a.block)
+}
+
+func (a *applier) inlineMarkup(parent interface{}, m InlineMarkup) InlineMarkup {
+	return applyList(a, parent, m, "inline markup", isA[Inline], a.inline)
+}
+
+func (a *applier) quotedContent(parent interface{}, content []interface{}) []interface{} {
+	return applyList(a, parent, content, "quoted block content", isQuotedContent, a.quotedContentItem)
+}
+
+func isA[T any](n interface{}) bool {
+	_, ok := n.(T)
+	return ok
+}
+
+func isQuotedContent(n interface{}) bool {
+	switch n.(type) {
+	case Structural, Block, InlineMarkup:
+		return true
+	default:
+		return false
+	}
+}
+
+// applyList applies the pre and post functions to each of the given nodes
+// in turn, using descend to produce a copy of each node with rewritten
+// content. The accepts function determines which nodes may be inserted
+// into the list or replace its nodes.
+func applyList[T any](a *applier, parent interface{}, in []T, list string, accepts func(interface{}) bool, descend func(T) T) []T {
+	if in == nil {
+		return nil
+	}
+	if a.stopped {
+		return in
+	}
+
+	ret := make([]T, 0, len(in))
+	for i, n := range in {
+		if a.stopped {
+			ret = append(ret, in[i:]...)
+			break
+		}
+
+		c := &ApplyCursor{
+			node:    n,
+			parent:  parent,
+			index:   i,
+			list:    list,
+			accepts: accepts,
+		}
+
+		if a.pre == nil || a.pre(c) {
+			if !c.deleted {
+				c.node = descend(c.node.(T))
+				if a.post != nil && !a.stopped && !a.post(c) {
+					a.stopped = true
+				}
+			}
+		}
+
+		for _, n := range c.before {
+			ret = append(ret, n.(T))
+		}
+		if !c.deleted {
+			ret = append(ret, c.node.(T))
+		}
+		for _, n := range c.after {
+			ret = append(ret, n.(T))
+		}
+	}
+	if len(ret) == 0 {
+		// Parsing produces nil for empty content, so we do the same to
+		// ensure the result is walked in the same way.
+		return nil
+	}
+	return ret
+}
+
+// elementHolder is implemented by all of the structural element types in
+// this package, via their embedded StructuralElement.
+type elementHolder interface {
+	element() *StructuralElement
+}
+
+func (m *StructuralElement) element() *StructuralElement {
+	return m
+}
+
+func (a *applier) structural(n Structural) Structural {
+	if _, ok := n.(elementHolder); !ok {
+		return n
+	}
+
+	ret := shallowCopy(n).(Structural)
+	e := ret.(elementHolder).element()
+	e.enumerator = a.inlineMarkup(ret, e.enumerator)
+	e.header = a.inlineMarkup(ret, e.header)
+	e.text = a.inlineMarkup(ret, e.text)
+	e.blocks = a.blockMarkup(ret, e.blocks)
+	e.childElements = a.structuralMarkup(ret, e.childElements)
+	e.continuationText = a.inlineMarkup(ret, e.continuationText)
+	return ret
+}
+
+func (a *applier) block(n Block) Block {
+	switch n := n.(type) {
+	case *QuotedBlock:
+		ret := *n
+		ret.Content = a.quotedContent(&ret, n.Content)
+		return &ret
+	case *List:
+		ret := *n
+		if n.Items != nil {
+			ret.Items = make([]InlineMarkup, len(n.Items))
+			for i, item := range n.Items {
+				ret.Items[i] = a.inlineMarkup(&ret, item)
+			}
+		}
+		return &ret
+	case *Table:
+		ret := *n
+		if n.Groups != nil {
+			ret.Groups = make([]*TableGroup, len(n.Groups))
+			for i, group := range n.Groups {
+				ret.Groups[i] = a.tableGroup(group)
+			}
+		}
+		return &ret
+	default:
+		return n
+	}
+}
+
+func (a *applier) tableGroup(n *TableGroup) *TableGroup {
+	ret := *n
+	ret.Head = a.tableRowSeq(n.Head)
+	if n.Bodies != nil {
+		ret.Bodies = make([]*TableRowSeq, len(n.Bodies))
+		for i, body := range n.Bodies {
+			ret.Bodies[i] = a.tableRowSeq(body)
+		}
+	}
+	ret.Foot = a.tableRowSeq(n.Foot)
+	return &ret
+}
+
+func (a *applier) tableRowSeq(n *TableRowSeq) *TableRowSeq {
+	if n == nil {
+		return nil
+	}
+	ret := *n
+	if n.Rows != nil {
+		ret.Rows = make([]TableRow, len(n.Rows))
+		for i, row := range n.Rows {
+			ret.Rows[i] = row
+			if row.Entries == nil {
+				continue
+			}
+			ret.Rows[i].Entries = make([]*TableEntry, len(row.Entries))
+			for j, entry := range row.Entries {
+				newEntry := *entry
+				newEntry.InlineMarkup = a.inlineMarkup(&newEntry, entry.InlineMarkup)
+				ret.Rows[i].Entries[j] = &newEntry
+			}
+		}
+	}
+	return &ret
+}
+
+var inlineMarkupType = reflect.TypeOf(InlineMarkup(nil))
+
+func (a *applier) inline(n Inline) Inline {
+	if _, ok := n.(Text); ok {
+		return n
+	}
+	if n.ChildNodes() == nil {
+		return n
+	}
+
+	// All of the inline element types with content embed InlineMarkup, so
+	// we can find it by reflection rather than handling each type.
+	v := reflect.ValueOf(n)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return n
+	}
+	st := v.Elem().Type()
+	for i := 0; i < st.NumField(); i++ {
+		f := st.Field(i)
+		if f.Anonymous && f.Type == inlineMarkupType {
+			ret := shallowCopy(n).(Inline)
+			fv := reflect.ValueOf(ret).Elem().Field(i)
+			children := fv.Interface().(InlineMarkup)
+			fv.Set(reflect.ValueOf(a.inlineMarkup(ret, children)))
+			return ret
+		}
+	}
+	return n
+}
+
+func (a *applier) quotedContentItem(n interface{}) interface{} {
+	switch n := n.(type) {
+	case Structural:
+		return a.structural(n)
+	case Block:
+		return a.block(n)
+	case InlineMarkup:
+		return a.inlineMarkup(n, n)
+	default:
+		return n
+	}
+}
+
+// shallowCopy returns a pointer to a new copy of the struct that the given
+// pointer refers to.
+func shallowCopy(n interface{}) interface{} {
+	v := reflect.ValueOf(n).Elem()
+	ret := reflect.New(v.Type())
+	ret.Elem().Set(v)
+	return ret.Interface()
+}
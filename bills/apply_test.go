@@ -0,0 +1,168 @@
+package bills
+
+import (
+	"encoding/xml"
+	"reflect"
+	"testing"
+
+	"github.com/davecgh/go-spew/spew"
+)
+
+const applyTestInput = `<legis-body>
+<section id="S1"><enum>1.</enum><header>Short title</header><text>This Act may be cited as the <short-title>Example Act</short-title>.<editorial>Note.</editorial></text></section>
+<section id="S2"><enum>2.</enum><header>Findings</header><text>Congress finds the following:</text>
+<paragraph id="P1"><enum>(1)</enum><text>First.</text></paragraph>
+<paragraph id="P2"><enum>(2)</enum><text>Second, per <sponsor name-id="X000001">Mr. Example</sponsor>.</text></paragraph>
+</section>
+</legis-body>`
+
+func parseApplyTestInput(t *testing.T) StructuralMarkup {
+	t.Helper()
+	var m StructuralMarkup
+	err := xml.Unmarshal([]byte(applyTestInput), &m)
+	if err != nil {
+		t.Fatalf("error: %s", err)
+	}
+	return m
+}
+
+func TestStructuralMarkupApply(t *testing.T) {
+	t.Run("no changes", func(t *testing.T) {
+		m := parseApplyTestInput(t)
+		got := m.Apply(nil, nil)
+		if !reflect.DeepEqual(got, m) {
+			t.Errorf("wrong result\ngot:  %s\nwant: %s", spew.Sdump(got), spew.Sdump(m))
+		}
+		if got[0] == m[0] {
+			t.Errorf("result shares elements with the original")
+		}
+	})
+
+	t.Run("delete and redact", func(t *testing.T) {
+		m := parseApplyTestInput(t)
+		got := m.Apply(func(c *ApplyCursor) bool {
+			switch n := c.Node().(type) {
+			case *Editorial:
+				c.Delete()
+			case *SponsorName:
+				c.Replace(&SponsorName{
+					InlineMarkup: InlineMarkup{Text("[REDACTED]")},
+					NameId:       n.NameId,
+				})
+				return false
+			}
+			return true
+		}, nil)
+
+		if got, want := got[0].Text().Text(), "This Act may be cited as the Example Act."; got != want {
+			t.Errorf("wrong first section text %q; want %q", got, want)
+		}
+		if got, want := got[1].ChildElements()[1].Text().Text(), "Second, per [REDACTED]."; got != want {
+			t.Errorf("wrong paragraph text %q; want %q", got, want)
+		}
+
+		// The original tree must be unchanged.
+		if got, want := m[0].Text().Text(), "This Act may be cited as the Example Act.Note."; got != want {
+			t.Errorf("original first section text changed to %q; want %q", got, want)
+		}
+		if got, want := m[1].ChildElements()[1].Text().Text(), "Second, per Mr. Example."; got != want {
+			t.Errorf("original paragraph text changed to %q; want %q", got, want)
+		}
+	})
+
+	t.Run("insert", func(t *testing.T) {
+		m := parseApplyTestInput(t)
+		extra := m[1].ChildElements()[0]
+		got := m.Apply(nil, func(c *ApplyCursor) bool {
+			if n, ok := c.Node().(Structural); ok && n.ID() == "P2" {
+				c.InsertBefore(n)
+				c.InsertAfter(extra)
+			}
+			return true
+		})
+
+		var ids []string
+		for _, n := range got[1].ChildElements() {
+			ids = append(ids, n.ID())
+		}
+		if want := []string{"P1", "P2", "P2", "P1"}; !reflect.DeepEqual(ids, want) {
+			t.Errorf("wrong children %#v; want %#v", ids, want)
+		}
+		if got, want := len(m[1].ChildElements()), 2; got != want {
+			t.Errorf("original has %d children; want %d", got, want)
+		}
+	})
+
+	t.Run("stop", func(t *testing.T) {
+		m := parseApplyTestInput(t)
+		var visited []string
+		got := m.Apply(nil, func(c *ApplyCursor) bool {
+			if n, ok := c.Node().(Structural); ok {
+				visited = append(visited, n.ID())
+				if n.ID() == "S1" {
+					c.Delete()
+					return false
+				}
+			}
+			return true
+		})
+
+		// The first section is deleted, and the traversal then stops so
+		// the second section is retained as-is.
+		if want := []string{"S1"}; !reflect.DeepEqual(visited, want) {
+			t.Errorf("visited %#v; want %#v", visited, want)
+		}
+		if got, want := len(got), 1; got != want {
+			t.Fatalf("wrong number of sections %d; want %d", got, want)
+		}
+		if got[0] != m[1] {
+			t.Errorf("second section was copied after traversal stopped")
+		}
+	})
+
+	t.Run("delete all text", func(t *testing.T) {
+		input := `<legis-body><section><text>Removed.</text><paragraph><enum>(1)</enum><text>Kept.</text></paragraph></section></legis-body>`
+		var m StructuralMarkup
+		err := xml.Unmarshal([]byte(input), &m)
+		if err != nil {
+			t.Fatalf("error: %s", err)
+		}
+
+		got := m.Apply(func(c *ApplyCursor) bool {
+			if _, ok := c.Parent().(*Section); ok {
+				if _, ok := c.Node().(Text); ok {
+					c.Delete()
+				}
+			}
+			return true
+		}, nil)
+
+		if text := got[0].Text(); text != nil {
+			t.Errorf("section text is %#v after deleting its content; want nil", text)
+		}
+
+		v := &cursorVisitor{}
+		got.Walk(v)
+		want := []string{
+			" depth=0 index=0 parent=- section= title=- quoted=-",
+			"(1) depth=1 index=0 parent= section= title=- quoted=-",
+			"text of (1)",
+		}
+		if !reflect.DeepEqual(v.log, want) {
+			t.Errorf("wrong walk\ngot:  %s\nwant: %s", spew.Sdump(v.log), spew.Sdump(want))
+		}
+	})
+
+	t.Run("wrong kind", func(t *testing.T) {
+		m := parseApplyTestInput(t)
+		defer func() {
+			if recover() == nil {
+				t.Errorf("Replace did not panic")
+			}
+		}()
+		m.Apply(func(c *ApplyCursor) bool {
+			c.Replace(Text("not structural"))
+			return true
+		}, nil)
+	})
+}
@@ -0,0 +1,128 @@
+package bills
+
+// AppropriationsMajor represents a major heading in an appropriations act,
+// which usually names a department or other large organizational unit.
+//
+// Appropriations headings are unusual among structural elements in that
+// the paragraphs they describe are not nested inside them, but instead
+// follow them as siblings. Use StructuralMarkup.AppropriationsAccounts to
+// associate headings with the content that follows them.
+type AppropriationsMajor struct {
+	StructuralElement
+}
+
+// AppropriationsIntermediate represents an intermediate heading in an
+// appropriations act, which usually names an agency or office within
+// the organization named by the preceding major heading.
+type AppropriationsIntermediate struct {
+	StructuralElement
+}
+
+// AppropriationsSmall represents the smallest level of heading in an
+// appropriations act, which usually names a specific account.
+type AppropriationsSmall struct {
+	StructuralElement
+}
+
+// AppropriationsAccount represents an appropriations heading along with the
+// structural elements that follow it, up until the next appropriations
+// heading.
+type AppropriationsAccount struct {
+	// Major, Intermediate and Small are the headings that are in effect
+	// for this account, or nil for levels that have no heading in effect.
+	// At least one of these is always non-nil.
+	Major        *AppropriationsMajor
+	Intermediate *AppropriationsIntermediate
+	Small        *AppropriationsSmall
+
+	// Content is the sequence of sibling elements following the most
+	// specific heading.
+	Content StructuralMarkup
+}
+
+// Heading returns the most specific heading in effect for the account.
+func (a *AppropriationsAccount) Heading() Structural {
+	switch {
+	case a.Small != nil:
+		return a.Small
+	case a.Intermediate != nil:
+		return a.Intermediate
+	default:
+		return a.Major
+	}
+}
+
+// AppropriationsAccounts groups the elements of the receiver by the
+// appropriations headings that precede them, descending into any other
+// structural elements (such as titles and divisions) that are not
+// themselves preceded by an appropriations heading.
+//
+// An account ends at the next appropriations heading or at the next
+// higher-level element, such as a title, that follows it. The content of
+// such an element is grouped separately, with no headings in effect from
+// before it.
+//
+// Major and intermediate headings that are immediately followed by another
+// appropriations heading produce no account of their own, since they
+// have no content.
+func (m StructuralMarkup) AppropriationsAccounts() []*AppropriationsAccount {
+	return m.appendAppropriationsAccounts(nil)
+}
+
+func (m StructuralMarkup) appendAppropriationsAccounts(ret []*AppropriationsAccount) []*AppropriationsAccount {
+	var current *AppropriationsAccount
+	var major *AppropriationsMajor
+	var intermediate *AppropriationsIntermediate
+
+	flush := func() {
+		if current == nil {
+			return
+		}
+		if current.Small != nil || len(current.Content) != 0 {
+			ret = append(ret, current)
+		}
+		current = nil
+	}
+
+	for _, n := range m {
+		switch tn := n.(type) {
+		case *AppropriationsMajor:
+			flush()
+			major = tn
+			intermediate = nil
+			current = &AppropriationsAccount{
+				Major: major,
+			}
+		case *AppropriationsIntermediate:
+			flush()
+			intermediate = tn
+			current = &AppropriationsAccount{
+				Major:        major,
+				Intermediate: intermediate,
+			}
+		case *AppropriationsSmall:
+			flush()
+			current = &AppropriationsAccount{
+				Major:        major,
+				Intermediate: intermediate,
+				Small:        tn,
+			}
+		case *Division, *Subdivision, *Title, *Subtitle, *Part, *Subpart, *Chapter, *SubChapter:
+			// A higher-level element ends any account that precedes it,
+			// and the headings in effect do not extend into it.
+			flush()
+			major = nil
+			intermediate = nil
+			ret = n.ChildElements().appendAppropriationsAccounts(ret)
+		default:
+			if current != nil {
+				current.Content = append(current.Content, n)
+				continue
+			}
+			ret = n.ChildElements().appendAppropriationsAccounts(ret)
+		}
+	}
+	flush()
+
+	return ret
+}
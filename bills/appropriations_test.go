@@ -0,0 +1,113 @@
+package bills
+
+import (
+	"encoding/xml"
+	"testing"
+)
+
+func TestAppropriationsAccounts(t *testing.T) {
+	input := `<legis-body>
+<title><enum>I</enum><header>AGRICULTURAL PROGRAMS</header>
+<appropriations-major><header>Production, Processing, and Marketing</header></appropriations-major>
+<appropriations-intermediate><header>Office of the Secretary</header></appropriations-intermediate>
+<paragraph><text>For necessary expenses of the Office of the Secretary, $45,555,000.</text></paragraph>
+<appropriations-small><header>Salaries and expenses</header></appropriations-small>
+<paragraph><text>For necessary salaries and expenses, $1,000,000.</text></paragraph>
+<paragraph><text>For additional salaries and expenses, $2,000,000.</text></paragraph>
+<appropriations-intermediate><header>Office of Tribal Relations</header></appropriations-intermediate>
+<appropriations-small><header>Salaries and expenses</header></appropriations-small>
+<paragraph><text>For necessary expenses of the Office of Tribal Relations, $502,000.</text></paragraph>
+</title>
+</legis-body>`
+
+	var m StructuralMarkup
+	err := xml.Unmarshal([]byte(input), &m)
+	if err != nil {
+		t.Fatalf("error: %s", err)
+	}
+
+	accts := m.AppropriationsAccounts()
+	if got, want := len(accts), 3; got != want {
+		t.Fatalf("wrong number of accounts %d; want %d", got, want)
+	}
+
+	tests := []struct {
+		Major        string
+		Intermediate string
+		Heading      string
+		Paragraphs   int
+	}{
+		{"Production, Processing, and Marketing", "Office of the Secretary", "Office of the Secretary", 1},
+		{"Production, Processing, and Marketing", "Office of the Secretary", "Salaries and expenses", 2},
+		{"Production, Processing, and Marketing", "Office of Tribal Relations", "Salaries and expenses", 1},
+	}
+
+	for i, test := range tests {
+		acct := accts[i]
+		if got, want := acct.Major.Header().Text(), test.Major; got != want {
+			t.Errorf("%d: wrong major heading %q; want %q", i, got, want)
+		}
+		if got, want := acct.Intermediate.Header().Text(), test.Intermediate; got != want {
+			t.Errorf("%d: wrong intermediate heading %q; want %q", i, got, want)
+		}
+		if got, want := acct.Heading().Header().Text(), test.Heading; got != want {
+			t.Errorf("%d: wrong heading %q; want %q", i, got, want)
+		}
+		if got, want := len(acct.Content), test.Paragraphs; got != want {
+			t.Errorf("%d: wrong number of paragraphs %d; want %d", i, got, want)
+		}
+		for _, n := range acct.Content {
+			if _, ok := n.(*Paragraph); !ok {
+				t.Errorf("%d: content element is %T; want *Paragraph", i, n)
+			}
+		}
+	}
+}
+
+func TestAppropriationsAccountsEndAtTitle(t *testing.T) {
+	input := `<legis-body>
+<title><enum>I</enum><header>AGRICULTURAL PROGRAMS</header>
+<appropriations-major><header>Production, Processing, and Marketing</header></appropriations-major>
+<appropriations-small><header>Salaries and expenses</header></appropriations-small>
+<paragraph><text>For necessary salaries and expenses, $1,000,000.</text></paragraph>
+</title>
+<appropriations-major><header>Rural Development Programs</header></appropriations-major>
+<appropriations-small><header>Rural housing service</header></appropriations-small>
+<paragraph><text>For rural housing, $3,000,000.</text></paragraph>
+<title><enum>II</enum><header>GENERAL PROVISIONS</header>
+<section><enum>201.</enum><text>No funds shall be used for first-class travel.</text></section>
+<appropriations-small><header>Office of the Inspector General</header></appropriations-small>
+<paragraph><text>For the Office of the Inspector General, $4,000,000.</text></paragraph>
+</title>
+</legis-body>`
+
+	var m StructuralMarkup
+	err := xml.Unmarshal([]byte(input), &m)
+	if err != nil {
+		t.Fatalf("error: %s", err)
+	}
+
+	accts := m.AppropriationsAccounts()
+	if got, want := len(accts), 3; got != want {
+		t.Fatalf("wrong number of accounts %d; want %d", got, want)
+	}
+
+	acct := accts[1]
+	if got, want := acct.Heading().Header().Text(), "Rural housing service"; got != want {
+		t.Errorf("wrong heading %q; want %q", got, want)
+	}
+	if got, want := len(acct.Content), 1; got != want {
+		t.Fatalf("wrong number of content elements %d; want %d", got, want)
+	}
+	if _, ok := acct.Content[0].(*Paragraph); !ok {
+		t.Errorf("content element is %T; want *Paragraph", acct.Content[0])
+	}
+
+	acct = accts[2]
+	if acct.Major != nil {
+		t.Errorf("major heading %q carried into title II; want nil", acct.Major.Header().Text())
+	}
+	if got, want := acct.Heading().Header().Text(), "Office of the Inspector General"; got != want {
+		t.Errorf("wrong heading %q; want %q", got, want)
+	}
+}
@@ -0,0 +1,37 @@
+package bills
+
+// Attestation represents the attestation block at the end of an engrossed
+// or enrolled document, where the officers of each chamber certify its
+// passage.
+type Attestation struct {
+	Groups []*AttestationGroup `xml:"attestation-group"`
+}
+
+// AttestationGroup represents a single certification within an
+// attestation, such as the Clerk's certification of passage by the House
+// or the Speaker's signature line on an enrolled bill.
+type AttestationGroup struct {
+	Date     *AttestationDate `xml:"attestation-date"`
+	Proxy    string           `xml:"proxy"`
+	Attestor string           `xml:"attestor"`
+	Role     string           `xml:"role"`
+}
+
+// AttestationDate represents the statement of when a chamber passed the
+// document, such as "Passed the House of Representatives November 16,
+// 2017."
+type AttestationDate struct {
+	HumanReadable string `xml:",chardata"`
+	Date          *Date  `xml:"date,attr"`
+	ChamberCode   string `xml:"chamber,attr"`
+}
+
+// Endorsement represents the endorsement printed on the back of a
+// document, which summarizes the most recent action taken on it.
+type Endorsement struct {
+	Date            *ActionDate    `xml:"action-date"`
+	Description     []InlineMarkup `xml:"action-desc"`
+	LegislationName string         `xml:"legis-num"`
+	TypeName        string         `xml:"legis-type"`
+	OfficialTitle   InlineMarkup   `xml:"official-title"`
+}
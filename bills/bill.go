@@ -6,8 +6,18 @@ import (
 )
 
 type Bill struct {
-	Form *Form `xml:"form"`
-	Body *Body `xml:"legis-body"`
+	Stage         BillStage     `xml:"bill-stage,attr"`
+	Type          BillType      `xml:"bill-type,attr"`
+	DMSId         string        `xml:"dms-id,attr"`
+	PublicPrivate PublicPrivate `xml:"public-private,attr"`
+	StageCount    int           `xml:"stage-count,attr"`
+
+	Metadata *Metadata `xml:"metadata"`
+	Form     *Form     `xml:"form"`
+	Body     *Body     `xml:"legis-body"`
+
+	Attestation *Attestation `xml:"attestation"`
+	Endorsement *Endorsement `xml:"endorsement"`
 }
 
 func ParseBill(r io.Reader) (*Bill, error) {
@@ -22,3 +32,228 @@ func ParseBillBuffer(buf []byte) (*Bill, error) {
 	err := xml.Unmarshal(buf, &bill)
 	return &bill, err
 }
+
+func (b *Bill) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	*b = Bill{}
+	err := decodeXMLAttrs(b, start)
+	if err != nil {
+		return err
+	}
+
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+
+		switch t := token.(type) {
+		case xml.EndElement:
+			// all done!
+			return nil
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "metadata":
+				b.Metadata = &Metadata{}
+				err := d.DecodeElement(b.Metadata, &t)
+				if err != nil {
+					return err
+				}
+			case "form":
+				b.Form = &Form{}
+				err := d.DecodeElement(b.Form, &t)
+				if err != nil {
+					return err
+				}
+			case "legis-body":
+				b.Body = &Body{}
+				err := d.DecodeElement(b.Body, &t)
+				if err != nil {
+					return err
+				}
+			case "attestation":
+				b.Attestation = &Attestation{}
+				err := d.DecodeElement(b.Attestation, &t)
+				if err != nil {
+					return err
+				}
+			case "endorsement":
+				b.Endorsement = &Endorsement{}
+				err := d.DecodeElement(b.Endorsement, &t)
+				if err != nil {
+					return err
+				}
+			default:
+				err := d.Skip()
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// IsIntroduced returns true if the bill is a version as introduced in
+// either chamber.
+func (b *Bill) IsIntroduced() bool {
+	return b.Stage.IsIntroduced()
+}
+
+// IsReported returns true if the bill is a version as reported by a
+// committee in either chamber.
+func (b *Bill) IsReported() bool {
+	return b.Stage.IsReported()
+}
+
+// IsEngrossed returns true if the bill is a version as passed by either
+// chamber, including engrossed amendments from the second chamber.
+func (b *Bill) IsEngrossed() bool {
+	return b.Stage.IsEngrossed()
+}
+
+// IsEnrolled returns true if the bill is the final version as passed by
+// both chambers and presented for signature.
+func (b *Bill) IsEnrolled() bool {
+	return b.Stage.IsEnrolled()
+}
+
+// BillStage is the value of the "bill-stage" attribute on a bill's root
+// element (or the "resolution-stage" attribute on a resolution's root
+// element), which describes which point in the legislative process the
+// document text represents.
+//
+// Documents may carry values that are not among the constants defined here,
+// so callers should be prepared to handle other values.
+type BillStage string
+
+const (
+	BillStageAdditionalSponsorsHouse    BillStage = "Additional-Sponsors-House"
+	BillStageAdditionalSponsorsSenate   BillStage = "Additional-Sponsors-Senate"
+	BillStageAgreedToHouse              BillStage = "Agreed-to-House"
+	BillStageAgreedToSenate             BillStage = "Agreed-to-Senate"
+	BillStageConsideredPassedHouse      BillStage = "Considered-and-Passed-House"
+	BillStageConsideredPassedSenate     BillStage = "Considered-and-Passed-Senate"
+	BillStageEngrossedAmendmentHouse    BillStage = "Engrossed-Amendment-House"
+	BillStageEngrossedAmendmentSenate   BillStage = "Engrossed-Amendment-Senate"
+	BillStageEngrossedInHouse           BillStage = "Engrossed-in-House"
+	BillStageEngrossedInSenate          BillStage = "Engrossed-in-Senate"
+	BillStageEnrolled                   BillStage = "Enrolled-Bill"
+	BillStageHeldAtDeskHouse            BillStage = "Held-at-Desk-House"
+	BillStageHeldAtDeskSenate           BillStage = "Held-at-Desk-Senate"
+	BillStageIntroducedInHouse          BillStage = "Introduced-in-House"
+	BillStageIntroducedInSenate         BillStage = "Introduced-in-Senate"
+	BillStagePlacedOnCalendarHouse      BillStage = "Placed-on-Calendar-House"
+	BillStagePlacedOnCalendarSenate     BillStage = "Placed-on-Calendar-Senate"
+	BillStagePrintedAsPassed            BillStage = "Printed-as-Passed"
+	BillStagePublicPrint                BillStage = "Public-Print"
+	BillStageReceivedInHouse            BillStage = "Received-in-House"
+	BillStageReceivedInSenate           BillStage = "Received-in-Senate"
+	BillStageReferenceChangeHouse       BillStage = "Reference-Change-House"
+	BillStageReferenceChangeSenate      BillStage = "Reference-Change-Senate"
+	BillStageReferralInstructionsHouse  BillStage = "Referral-Instructions-House"
+	BillStageReferralInstructionsSenate BillStage = "Referral-Instructions-Senate"
+	BillStageReferredInHouse            BillStage = "Referred-in-House"
+	BillStageReferredInSenate           BillStage = "Referred-in-Senate"
+	BillStageReportedInHouse            BillStage = "Reported-in-House"
+	BillStageReportedInSenate           BillStage = "Reported-in-Senate"
+)
+
+// String returns the stage as it appears in the source document.
+func (s BillStage) String() string {
+	return string(s)
+}
+
+// Known returns true if the stage is one of the constants defined above.
+func (s BillStage) Known() bool {
+	switch s {
+	case BillStageAdditionalSponsorsHouse, BillStageAdditionalSponsorsSenate,
+		BillStageAgreedToHouse, BillStageAgreedToSenate,
+		BillStageConsideredPassedHouse, BillStageConsideredPassedSenate,
+		BillStageEngrossedAmendmentHouse, BillStageEngrossedAmendmentSenate,
+		BillStageEngrossedInHouse, BillStageEngrossedInSenate,
+		BillStageEnrolled,
+		BillStageHeldAtDeskHouse, BillStageHeldAtDeskSenate,
+		BillStageIntroducedInHouse, BillStageIntroducedInSenate,
+		BillStagePlacedOnCalendarHouse, BillStagePlacedOnCalendarSenate,
+		BillStagePrintedAsPassed, BillStagePublicPrint,
+		BillStageReceivedInHouse, BillStageReceivedInSenate,
+		BillStageReferenceChangeHouse, BillStageReferenceChangeSenate,
+		BillStageReferralInstructionsHouse, BillStageReferralInstructionsSenate,
+		BillStageReferredInHouse, BillStageReferredInSenate,
+		BillStageReportedInHouse, BillStageReportedInSenate:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsIntroduced returns true for the stages of a version as introduced in
+// either chamber.
+func (s BillStage) IsIntroduced() bool {
+	return s == BillStageIntroducedInHouse || s == BillStageIntroducedInSenate
+}
+
+// IsReported returns true for the stages of a version as reported by a
+// committee in either chamber.
+func (s BillStage) IsReported() bool {
+	return s == BillStageReportedInHouse || s == BillStageReportedInSenate
+}
+
+// IsEngrossed returns true for the stages of a version as passed by either
+// chamber, including engrossed amendments from the second chamber.
+func (s BillStage) IsEngrossed() bool {
+	switch s {
+	case BillStageEngrossedInHouse, BillStageEngrossedInSenate,
+		BillStageEngrossedAmendmentHouse, BillStageEngrossedAmendmentSenate:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsEnrolled returns true for the stage of the final version as passed by
+// both chambers and presented for signature.
+func (s BillStage) IsEnrolled() bool {
+	return s == BillStageEnrolled
+}
+
+// BillType is the value of the "bill-type" attribute on a document's root
+// element, which selects between the drafting conventions used for the
+// document.
+type BillType string
+
+const (
+	BillTypeOLC            BillType = "olc"
+	BillTypeTraditional    BillType = "traditional"
+	BillTypeAppropriations BillType = "appropriations"
+)
+
+func (t BillType) String() string {
+	return string(t)
+}
+
+func (t BillType) Known() bool {
+	switch t {
+	case BillTypeOLC, BillTypeTraditional, BillTypeAppropriations:
+		return true
+	default:
+		return false
+	}
+}
+
+// PublicPrivate is the value of the "public-private" attribute on a
+// document's root element, distinguishing public legislation from private
+// legislation that concerns only specific individuals or entities.
+type PublicPrivate string
+
+const (
+	Public  PublicPrivate = "public"
+	Private PublicPrivate = "private"
+)
+
+func (p PublicPrivate) String() string {
+	return string(p)
+}
+
+func (p PublicPrivate) Known() bool {
+	return p == Public || p == Private
+}
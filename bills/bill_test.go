@@ -0,0 +1,183 @@
+package bills
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/davecgh/go-spew/spew"
+)
+
+func TestParseBillMetadata(t *testing.T) {
+	input := `<bill>
+<metadata xmlns:dc="http://purl.org/dc/elements/1.1/">
+<dublinCore>
+<dc:title>115 HR 1 IH: Tax Cuts and Jobs Act</dc:title>
+<dc:publisher>U.S. House of Representatives</dc:publisher>
+<dc:date>2017-11-02</dc:date>
+<dc:format>text/xml</dc:format>
+<dc:language>EN</dc:language>
+<dc:rights>Pursuant to Title 17 Section 105 of the United States Code, this file is not subject to copyright protection and is in the public domain.</dc:rights>
+</dublinCore>
+</metadata>
+</bill>`
+
+	bill, err := ParseBillBuffer([]byte(input))
+	if err != nil {
+		t.Fatalf("error: %s", err)
+	}
+
+	want := &Metadata{
+		DublinCore: &DublinCore{
+			Title:     "115 HR 1 IH: Tax Cuts and Jobs Act",
+			Publisher: "U.S. House of Representatives",
+			Date: &MetadataDate{
+				Date:  Date{Year: 2017, Month: time.November, Day: 2},
+				Valid: true,
+			},
+			Format:   "text/xml",
+			Language: "EN",
+			Rights:   "Pursuant to Title 17 Section 105 of the United States Code, this file is not subject to copyright protection and is in the public domain.",
+		},
+	}
+
+	if !reflect.DeepEqual(bill.Metadata, want) {
+		t.Errorf(
+			"incorrect result\ngot:  %s\nwant: %s",
+			spew.Sdump(bill.Metadata),
+			spew.Sdump(want),
+		)
+	}
+}
+
+func TestBillJSON(t *testing.T) {
+	input := `<bill bill-stage="Introduced-in-House">
+<metadata xmlns:dc="http://purl.org/dc/elements/1.1/">
+<dublinCore>
+<dc:title>115 HR 1 IH: Tax Cuts and Jobs Act</dc:title>
+<dc:date></dc:date>
+</dublinCore>
+</metadata>
+<form><action><action-date date="20171102">November 2, 2017</action-date></action></form>
+<legis-body><section><enum>1.</enum><header>Short title</header><text>This Act may be cited as the Tax Cuts and Jobs Act.</text></section></legis-body>
+</bill>`
+
+	bill, err := ParseBillBuffer([]byte(input))
+	if err != nil {
+		t.Fatalf("error: %s", err)
+	}
+
+	_, err = json.Marshal(bill)
+	if err != nil {
+		t.Fatalf("error encoding bill: %s", err)
+	}
+
+	buf, err := json.Marshal(bill.Metadata.DublinCore.Date)
+	if err != nil {
+		t.Fatalf("error encoding empty date: %s", err)
+	}
+	if got, want := string(buf), "null"; got != want {
+		t.Errorf("empty date encoded as %s; want %s", got, want)
+	}
+
+	valid := MetadataDate{Date: Date{Year: 2017, Month: time.November, Day: 2}, Valid: true}
+	buf, err = json.Marshal(valid)
+	if err != nil {
+		t.Fatalf("error encoding valid date: %s", err)
+	}
+	if got, want := string(buf), `"2017-11-02"`; got != want {
+		t.Errorf("valid date encoded as %s; want %s", got, want)
+	}
+	var got MetadataDate
+	err = json.Unmarshal(buf, &got)
+	if err != nil {
+		t.Fatalf("error decoding valid date: %s", err)
+	}
+	if got != valid {
+		t.Errorf("valid date decoded as %#v; want %#v", got, valid)
+	}
+}
+
+func TestParseBillAttributes(t *testing.T) {
+	input := `<bill bill-stage="Enrolled-Bill" dms-id="HE2E1B5E8" public-private="public" bill-type="olc" stage-count="1"></bill>`
+
+	bill, err := ParseBillBuffer([]byte(input))
+	if err != nil {
+		t.Fatalf("error: %s", err)
+	}
+
+	if got, want := bill.Stage, BillStageEnrolled; got != want {
+		t.Errorf("wrong Stage %q; want %q", got, want)
+	}
+	if got, want := bill.Type, BillTypeOLC; got != want {
+		t.Errorf("wrong Type %q; want %q", got, want)
+	}
+	if got, want := bill.DMSId, "HE2E1B5E8"; got != want {
+		t.Errorf("wrong DMSId %q; want %q", got, want)
+	}
+	if got, want := bill.PublicPrivate, Public; got != want {
+		t.Errorf("wrong PublicPrivate %q; want %q", got, want)
+	}
+	if got, want := bill.StageCount, 1; got != want {
+		t.Errorf("wrong StageCount %d; want %d", got, want)
+	}
+	if !bill.IsEnrolled() {
+		t.Errorf("IsEnrolled returned false; want true")
+	}
+	if bill.IsIntroduced() {
+		t.Errorf("IsIntroduced returned true; want false")
+	}
+}
+
+func TestParseBillAttestation(t *testing.T) {
+	input := `<bill bill-stage="Engrossed-in-House">
+<legis-body><section><text>Text.</text></section></legis-body>
+<attestation>
+<attestation-group>
+<attestation-date date="20171116" chamber="House">Passed the House of Representatives November 16, 2017.</attestation-date>
+<attestor display="no">Karen L. Haas,</attestor>
+<role>Clerk.</role>
+</attestation-group>
+</attestation>
+<endorsement display="yes">
+<action-date date="20171127">November 27, 2017</action-date>
+<action-desc>Received; read twice and placed on the calendar</action-desc>
+</endorsement>
+</bill>`
+
+	bill, err := ParseBillBuffer([]byte(input))
+	if err != nil {
+		t.Fatalf("error: %s", err)
+	}
+
+	if bill.Attestation == nil {
+		t.Fatalf("Attestation is nil")
+	}
+	if got, want := len(bill.Attestation.Groups), 1; got != want {
+		t.Fatalf("wrong number of attestation groups %d; want %d", got, want)
+	}
+	group := bill.Attestation.Groups[0]
+	if group.Date == nil {
+		t.Fatalf("attestation group has no date")
+	}
+	if got, want := *group.Date.Date, (Date{Year: 2017, Month: time.November, Day: 16}); got != want {
+		t.Errorf("wrong attestation date %#v; want %#v", got, want)
+	}
+	if got, want := group.Date.ChamberCode, "House"; got != want {
+		t.Errorf("wrong attestation ChamberCode %q; want %q", got, want)
+	}
+	if got, want := group.Attestor, "Karen L. Haas,"; got != want {
+		t.Errorf("wrong Attestor %q; want %q", got, want)
+	}
+	if got, want := group.Role, "Clerk."; got != want {
+		t.Errorf("wrong Role %q; want %q", got, want)
+	}
+
+	if bill.Endorsement == nil {
+		t.Fatalf("Endorsement is nil")
+	}
+	if got, want := *bill.Endorsement.Date.EventDate, (Date{Year: 2017, Month: time.November, Day: 27}); got != want {
+		t.Errorf("wrong endorsement date %#v; want %#v", got, want)
+	}
+}
@@ -93,7 +93,7 @@ type QuotedBlock struct {
 	ActName      string `xml:"act-name,attr"`
 	Id           string `xml:"id,attr"`
 	ParsableCite string `xml:"parsable-cite,attr"`
-	StyleCode    string `xml:"style,attr"`
+	StyleCode    Style  `xml:"style,attr"`
 
 	// Quoted blocks have a mixed content model. Content elements can either be
 	// implementations of Block or Structural or they can be InlineMarkup
@@ -133,44 +133,42 @@ func (n *QuotedBlock) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error
 				continue
 			}
 
-			// Quote blocks can contain both block elements and structural
-			// elements, so we need to recognize which one we're working
-			// with and decode as appropriate.
-			switch {
-			case isBlockElement(t.Name):
-				obj, err := decodeBlockElement(d, t)
-				if err != nil {
-					return err
-				}
-				n.Content = append(n.Content, obj)
-			case t.Name.Local == "text":
-				var obj InlineMarkup
-				err := d.DecodeElement(&obj, &t)
-				if err != nil {
-					return err
-				}
-				n.Content = append(n.Content, obj)
-			default:
-				obj, err := decodeStructuralElement(d, t)
-				if err != nil {
-					return err
-				}
-				n.Content = append(n.Content, obj)
+			obj, err := decodeQuotedContent(d, t)
+			if err != nil {
+				return err
 			}
+			n.Content = append(n.Content, obj)
 		}
 	}
+}
 
-	return nil
+// decodeQuotedContent decodes a single child element of an element that
+// uses the mixed content model of quoted text, where each child can be
+// either a block element, a structural element or a directly-quoted
+// paragraph of text.
+//
+// The result is therefore either a Block, a Structural or an InlineMarkup.
+func decodeQuotedContent(d *xml.Decoder, start xml.StartElement) (interface{}, error) {
+	switch {
+	case isBlockElement(start.Name):
+		return decodeBlockElement(d, start)
+	case start.Name.Local == "text":
+		var obj InlineMarkup
+		err := d.DecodeElement(&obj, &start)
+		return obj, err
+	default:
+		return decodeStructuralElement(d, start)
+	}
 }
 
 type Graphic struct {
-	Depth          string `xml:"depth,attr"`
-	File           string `xml:"file,attr"`
-	Description    string `xml:"graphic-desc,attr"`
-	Indent         string `xml:"graphic-indent,attr"`
-	HorizAlignCode string `xml:"halign,attr"`
-	RotationCode   string `xml:"rotation,attr`
-	Span           string `xml:"span,attr"`
+	Depth          string    `xml:"depth,attr"`
+	File           string    `xml:"file,attr"`
+	Description    string    `xml:"graphic-desc,attr"`
+	Indent         string    `xml:"graphic-indent,attr"`
+	HorizAlignCode Alignment `xml:"halign,attr"`
+	RotationCode   Rotation  `xml:"rotation,attr"`
+	Span           string    `xml:"span,attr"`
 }
 
 func (n *Graphic) Block() {
@@ -185,12 +183,12 @@ func (n *Formula) Block() {
 }
 
 type TableOfContents struct {
-	ContainerLevelCode    string `xml:"container-level,attr"`
-	IdRef                 string `xml:"idref,attr"`
-	LowestBoldedLevelCode string `xml:"lowest-bolded-level,attr"`
-	LowestLevelCode       string `xml:"lowest-level,attr"`
-	QuotedBlockCode       string `xml:"quoted-block,attr"`
-	RegenerationCode      string `xml:"regeneration,attr"`
+	ContainerLevelCode    ContainerLevel  `xml:"container-level,attr"`
+	IdRef                 string          `xml:"idref,attr"`
+	LowestBoldedLevelCode BoldedLevel     `xml:"lowest-bolded-level,attr"`
+	LowestLevelCode       Level           `xml:"lowest-level,attr"`
+	QuotedBlockCode       QuotedBlockFlag `xml:"quoted-block,attr"`
+	RegenerationCode      Regeneration    `xml:"regeneration,attr"`
 
 	Header               InlineMarkup `xml:"header"`
 	InstructiveParagraph InlineMarkup `xml:"instructive-para"`
@@ -238,10 +236,14 @@ func (n *TableOfContents) UnmarshalXML(d *xml.Decoder, start xml.StartElement) e
 			}
 		}
 	}
-	return nil
 }
 
 type Table struct {
+	Frame     TableFrame `xml:"frame,attr"`
+	ColSep    *bool      `xml:"colsep,attr"`
+	RowSep    *bool      `xml:"rowsep,attr"`
+	TableType string     `xml:"table-type,attr"`
+
 	Titles       []string      `xml:"ttitle"`
 	Descriptions []string      `xml:"tdesc"`
 	Groups       []*TableGroup `xml:"tgroup"`
@@ -5,7 +5,7 @@ import (
 )
 
 type Body struct {
-	StyleCode string `xml:"style,attr"`
+	StyleCode Style `xml:"style,attr"`
 	StructuralMarkup
 }
 
@@ -0,0 +1,23 @@
+package bills
+
+// Chamber represents one of the two chambers of Congress.
+type Chamber int
+
+const (
+	// NoChamber is used where something is not specific to either chamber,
+	// such as the enrolled version of a bill.
+	NoChamber Chamber = iota
+	House
+	Senate
+)
+
+func (c Chamber) String() string {
+	switch c {
+	case House:
+		return "House"
+	case Senate:
+		return "Senate"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,397 @@
+package bills
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Code is implemented by the enumeration types used for attributes whose
+// values are restricted by the DTD.
+//
+// Parsing is lenient: attribute values that are not among the constants
+// defined for a type are retained as-is, and Known returns false for them.
+// Callers that prefer to reject such documents can use ValidateCodes.
+type Code interface {
+	fmt.Stringer
+
+	// Known returns true if the value is one of the constants defined for
+	// its type.
+	Known() bool
+}
+
+// ValidateCodes checks all of the Code values reachable from the given
+// parsed document or element, returning an error describing the first
+// one that is not known.
+//
+// Empty values are ignored, since they represent attributes that were not
+// present in the source document.
+func ValidateCodes(v interface{}) error {
+	return validateCodes(reflect.ValueOf(v))
+}
+
+var codeType = reflect.TypeOf((*Code)(nil)).Elem()
+
+func validateCodes(v reflect.Value) error {
+	if !v.IsValid() {
+		return nil
+	}
+
+	if v.Kind() == reflect.String && v.Type().Implements(codeType) {
+		if v.Len() == 0 {
+			return nil
+		}
+		// v may have been reached through unexported fields, so we make
+		// a fresh copy before converting it to an interface value.
+		cv := reflect.New(v.Type()).Elem()
+		cv.SetString(v.String())
+		code := cv.Interface().(Code)
+		if !code.Known() {
+			return fmt.Errorf("unknown %s value %q", v.Type().Name(), code.String())
+		}
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return validateCodes(v.Elem())
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			err := validateCodes(v.Field(i))
+			if err != nil {
+				return err
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			err := validateCodes(v.Index(i))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Style is the value of the "style" attribute on a body, quoted block or
+// quoted TOC entry, which selects the drafting conventions used for the
+// content.
+type Style string
+
+const (
+	StyleOLC                          Style = "OLC"
+	StyleTraditional                  Style = "traditional"
+	StyleUSC                          Style = "USC"
+	StyleTax                          Style = "tax"
+	StyleAppropriations               Style = "appropriations"
+	StyleArchaic                      Style = "archaic"
+	StyleArchaicParagraph             Style = "archaic-paragraph"
+	StyleConstitutionalAmendment      Style = "constitutional-amendment"
+	StyleDefense                      Style = "defense"
+	StyleUniformCodeOfMilitaryJustice Style = "uniform-code-of-military-justice"
+	StyleOther                        Style = "other"
+)
+
+func (s Style) String() string {
+	return string(s)
+}
+
+func (s Style) Known() bool {
+	switch s {
+	case StyleOLC, StyleTraditional, StyleUSC, StyleTax, StyleAppropriations,
+		StyleArchaic, StyleArchaicParagraph, StyleConstitutionalAmendment,
+		StyleDefense, StyleUniformCodeOfMilitaryJustice, StyleOther:
+		return true
+	default:
+		return false
+	}
+}
+
+// Level names a kind of structural element in the attributes of tables of
+// contents and their entries.
+type Level string
+
+const (
+	LevelDivision                   Level = "division"
+	LevelSubdivision                Level = "subdivision"
+	LevelTitle                      Level = "title"
+	LevelSubtitle                   Level = "subtitle"
+	LevelPart                       Level = "part"
+	LevelSubpart                    Level = "subpart"
+	LevelChapter                    Level = "chapter"
+	LevelSubchapter                 Level = "subchapter"
+	LevelSection                    Level = "section"
+	LevelSubsection                 Level = "subsection"
+	LevelParagraph                  Level = "paragraph"
+	LevelSubparagraph               Level = "subparagraph"
+	LevelClause                     Level = "clause"
+	LevelSubclause                  Level = "subclause"
+	LevelItem                       Level = "item"
+	LevelSubitem                    Level = "subitem"
+	LevelAppropriationsMajor        Level = "appropriations-major"
+	LevelAppropriationsIntermediate Level = "appropriations-intermediate"
+	LevelAppropriationsSmall        Level = "appropriations-small"
+)
+
+func (l Level) String() string {
+	return string(l)
+}
+
+func (l Level) Known() bool {
+	switch l {
+	case LevelDivision, LevelSubdivision, LevelTitle, LevelSubtitle,
+		LevelPart, LevelSubpart, LevelChapter, LevelSubchapter,
+		LevelSection, LevelSubsection, LevelParagraph, LevelSubparagraph,
+		LevelClause, LevelSubclause, LevelItem, LevelSubitem,
+		LevelAppropriationsMajor, LevelAppropriationsIntermediate,
+		LevelAppropriationsSmall:
+		return true
+	default:
+		return false
+	}
+}
+
+// Toggle is the value of an on/off attribute, such as "bold" on a TOC
+// entry.
+type Toggle string
+
+const (
+	ToggleOn  Toggle = "on"
+	ToggleOff Toggle = "off"
+)
+
+func (t Toggle) String() string {
+	return string(t)
+}
+
+func (t Toggle) Known() bool {
+	return t == ToggleOn || t == ToggleOff
+}
+
+// ContainerLevel is the value of the "container-level" attribute on a table
+// of contents, which identifies the kind of element whose content it lists.
+type ContainerLevel string
+
+const (
+	ContainerLegisBody      ContainerLevel = "legis-body-container"
+	ContainerDivision       ContainerLevel = "division-container"
+	ContainerSubdivision    ContainerLevel = "subdivision-container"
+	ContainerTitle          ContainerLevel = "title-container"
+	ContainerSubtitle       ContainerLevel = "subtitle-container"
+	ContainerPart           ContainerLevel = "part-container"
+	ContainerSubpart        ContainerLevel = "subpart-container"
+	ContainerChapter        ContainerLevel = "chapter-container"
+	ContainerSubchapter     ContainerLevel = "subchapter-container"
+	ContainerQuotedBlock    ContainerLevel = "quoted-block-container"
+	ContainerResolutionBody ContainerLevel = "resolution-body-container"
+)
+
+func (l ContainerLevel) String() string {
+	return string(l)
+}
+
+func (l ContainerLevel) Known() bool {
+	switch l {
+	case ContainerLegisBody, ContainerDivision, ContainerSubdivision,
+		ContainerTitle, ContainerSubtitle, ContainerPart, ContainerSubpart,
+		ContainerChapter, ContainerSubchapter, ContainerQuotedBlock,
+		ContainerResolutionBody:
+		return true
+	default:
+		return false
+	}
+}
+
+// BoldedLevel is the value of the "lowest-bolded-level" attribute on a table
+// of contents, which gives the lowest level of entry shown in bold.
+type BoldedLevel string
+
+const (
+	BoldedDivision    BoldedLevel = "division-lowest-bolded"
+	BoldedSubdivision BoldedLevel = "subdivision-lowest-bolded"
+	BoldedTitle       BoldedLevel = "title-lowest-bolded"
+	BoldedSubtitle    BoldedLevel = "subtitle-lowest-bolded"
+	BoldedPart        BoldedLevel = "part-lowest-bolded"
+	BoldedSubpart     BoldedLevel = "subpart-lowest-bolded"
+	BoldedChapter     BoldedLevel = "chapter-lowest-bolded"
+	BoldedSubchapter  BoldedLevel = "subchapter-lowest-bolded"
+	BoldedSection     BoldedLevel = "section-lowest-bolded"
+)
+
+func (l BoldedLevel) String() string {
+	return string(l)
+}
+
+func (l BoldedLevel) Known() bool {
+	switch l {
+	case BoldedDivision, BoldedSubdivision, BoldedTitle, BoldedSubtitle,
+		BoldedPart, BoldedSubpart, BoldedChapter, BoldedSubchapter,
+		BoldedSection:
+		return true
+	default:
+		return false
+	}
+}
+
+// QuotedBlockFlag is the value of the "quoted-block" attribute on a table of
+// contents, which indicates whether it appears within a quoted block.
+type QuotedBlockFlag string
+
+const (
+	QuotedBlockYes QuotedBlockFlag = "yes-quoted-block"
+	QuotedBlockNo  QuotedBlockFlag = "no-quoted-block"
+)
+
+func (f QuotedBlockFlag) String() string {
+	return string(f)
+}
+
+func (f QuotedBlockFlag) Known() bool {
+	return f == QuotedBlockYes || f == QuotedBlockNo
+}
+
+// Regeneration is the value of the "regeneration" attribute on a table of
+// contents, which indicates whether it should be regenerated from the
+// headings in the document.
+type Regeneration string
+
+const (
+	RegenerationYes Regeneration = "yes-regeneration"
+	RegenerationNo  Regeneration = "no-regeneration"
+)
+
+func (r Regeneration) String() string {
+	return string(r)
+}
+
+func (r Regeneration) Known() bool {
+	return r == RegenerationYes || r == RegenerationNo
+}
+
+// Alignment is the value of a horizontal alignment attribute.
+type Alignment string
+
+const (
+	AlignLeft    Alignment = "left"
+	AlignCenter  Alignment = "center"
+	AlignRight   Alignment = "right"
+	AlignJustify Alignment = "justify"
+	AlignChar    Alignment = "char"
+)
+
+func (a Alignment) String() string {
+	return string(a)
+}
+
+func (a Alignment) Known() bool {
+	switch a {
+	case AlignLeft, AlignCenter, AlignRight, AlignJustify, AlignChar:
+		return true
+	default:
+		return false
+	}
+}
+
+// Rotation is the value of the "rotation" attribute on a graphic, giving
+// the clockwise rotation in degrees.
+type Rotation string
+
+const (
+	Rotation0   Rotation = "0"
+	Rotation90  Rotation = "90"
+	Rotation180 Rotation = "180"
+	Rotation270 Rotation = "270"
+)
+
+func (r Rotation) String() string {
+	return string(r)
+}
+
+func (r Rotation) Known() bool {
+	switch r {
+	case Rotation0, Rotation90, Rotation180, Rotation270:
+		return true
+	default:
+		return false
+	}
+}
+
+// LegalDocType is the value of the "legal-doc" attribute on an external
+// cross-reference, which identifies the kind of document being referenced.
+type LegalDocType string
+
+const (
+	LegalDocUSC            LegalDocType = "usc"
+	LegalDocUSCChapter     LegalDocType = "usc-chapter"
+	LegalDocUSCAppendix    LegalDocType = "usc-appendix"
+	LegalDocUSCAct         LegalDocType = "usc-act"
+	LegalDocPublicLaw      LegalDocType = "public-law"
+	LegalDocPrivateLaw     LegalDocType = "private-law"
+	LegalDocStatuteAtLarge LegalDocType = "statute-at-large"
+	LegalDocExecutiveOrder LegalDocType = "executive-order"
+	LegalDocRegulation     LegalDocType = "regulation"
+	LegalDocHouseRule      LegalDocType = "house-rule"
+	LegalDocSenateRule     LegalDocType = "senate-rule"
+)
+
+func (t LegalDocType) String() string {
+	return string(t)
+}
+
+func (t LegalDocType) Known() bool {
+	switch t {
+	case LegalDocUSC, LegalDocUSCChapter, LegalDocUSCAppendix, LegalDocUSCAct,
+		LegalDocPublicLaw, LegalDocPrivateLaw, LegalDocStatuteAtLarge,
+		LegalDocExecutiveOrder, LegalDocRegulation, LegalDocHouseRule,
+		LegalDocSenateRule:
+		return true
+	default:
+		return false
+	}
+}
+
+// VerticalAlignment is the value of the "valign" attribute on table rows
+// and entries.
+type VerticalAlignment string
+
+const (
+	VAlignTop    VerticalAlignment = "top"
+	VAlignMiddle VerticalAlignment = "middle"
+	VAlignBottom VerticalAlignment = "bottom"
+)
+
+func (a VerticalAlignment) String() string {
+	return string(a)
+}
+
+func (a VerticalAlignment) Known() bool {
+	return a == VAlignTop || a == VAlignMiddle || a == VAlignBottom
+}
+
+// TableFrame is the value of the "frame" attribute on a table, which
+// selects which of its outer edges have rules.
+type TableFrame string
+
+const (
+	FrameAll    TableFrame = "all"
+	FrameBottom TableFrame = "bottom"
+	FrameNone   TableFrame = "none"
+	FrameSides  TableFrame = "sides"
+	FrameTop    TableFrame = "top"
+	FrameTopBot TableFrame = "topbot"
+)
+
+func (f TableFrame) String() string {
+	return string(f)
+}
+
+func (f TableFrame) Known() bool {
+	switch f {
+	case FrameAll, FrameBottom, FrameNone, FrameSides, FrameTop, FrameTopBot:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,78 @@
+package bills
+
+import (
+	"testing"
+)
+
+func TestCodes(t *testing.T) {
+	input := `<bill bill-stage="Introduced-in-House" bill-type="olc">
+<form>
+<action stage="Introduced-in-House"><action-date date="20171102">November 2, 2017</action-date></action>
+</form>
+<legis-body style="OLC">
+<section id="H1"><enum>1.</enum><header>Short title</header><text>See <external-xref legal-doc="usc" parsable-cite="usc/26/1">section 1</external-xref>.</text>
+<toc container-level="legis-body-container" quoted-block="no-quoted-block" lowest-level="section" lowest-bolded-level="division-lowest-bolded" regeneration="yes-regeneration">
+<toc-entry idref="H1" level="section" bold="off">Sec. 1. Short title.</toc-entry>
+</toc>
+</section>
+</legis-body>
+</bill>`
+
+	bill, err := ParseBillBuffer([]byte(input))
+	if err != nil {
+		t.Fatalf("error: %s", err)
+	}
+
+	if got, want := bill.Form.Actions[0].StageCode, BillStageIntroducedInHouse; got != want {
+		t.Errorf("wrong action StageCode %q; want %q", got, want)
+	}
+	if got, want := bill.Body.StyleCode, StyleOLC; got != want {
+		t.Errorf("wrong body StyleCode %q; want %q", got, want)
+	}
+
+	section := bill.Body.StructuralMarkup[0].(*Section)
+	toc := section.Blocks()[0].(*TableOfContents)
+	if got, want := toc.ContainerLevelCode, ContainerLegisBody; got != want {
+		t.Errorf("wrong TOC ContainerLevelCode %q; want %q", got, want)
+	}
+	if got, want := toc.LowestBoldedLevelCode, BoldedDivision; got != want {
+		t.Errorf("wrong TOC LowestBoldedLevelCode %q; want %q", got, want)
+	}
+	if got, want := toc.LowestLevelCode, LevelSection; got != want {
+		t.Errorf("wrong TOC LowestLevelCode %q; want %q", got, want)
+	}
+	if got, want := toc.QuotedBlockCode, QuotedBlockNo; got != want {
+		t.Errorf("wrong TOC QuotedBlockCode %q; want %q", got, want)
+	}
+	if got, want := toc.RegenerationCode, RegenerationYes; got != want {
+		t.Errorf("wrong TOC RegenerationCode %q; want %q", got, want)
+	}
+	entry := toc.Entries[0].(*SimpleTOCEntry)
+	if got, want := entry.LevelCode, LevelSection; got != want {
+		t.Errorf("wrong TOC entry LevelCode %q; want %q", got, want)
+	}
+	if got, want := entry.BoldCode, ToggleOff; got != want {
+		t.Errorf("wrong TOC entry BoldCode %q; want %q", got, want)
+	}
+
+	xref := section.Text()[1].(*ExternalCrossReference)
+	if got, want := xref.TargetTypeCode, LegalDocUSC; got != want {
+		t.Errorf("wrong TargetTypeCode %q; want %q", got, want)
+	}
+
+	err = ValidateCodes(bill)
+	if err != nil {
+		t.Fatalf("ValidateCodes failed: %s", err)
+	}
+
+	// Values outside of those the DTD allows are retained by the parser,
+	// but rejected by strict validation.
+	toc.QuotedBlockCode = "no"
+	err = ValidateCodes(bill)
+	if err == nil {
+		t.Fatalf("ValidateCodes succeeded; want error")
+	}
+	if got, want := err.Error(), `unknown QuotedBlockFlag value "no"`; got != want {
+		t.Errorf("wrong error %q; want %q", got, want)
+	}
+}
@@ -0,0 +1,240 @@
+package bills
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ParseCongress parses a congress name such as "115th CONGRESS", as found
+// in Form.CongressName, and returns the number of the congress.
+//
+// The word "Congress" is optional and matched case-insensitively, but the
+// ordinal suffix must agree with the number. Both the GPO style ("2d",
+// "3d") and the common style ("2nd", "3rd") are accepted.
+//
+// The spelled-out form used in enrolled bills, such as "One Hundred
+// Fifteenth Congress of the United States of America", is also accepted.
+func ParseCongress(s string) (int, error) {
+	n, err := parseOrdinalName(s, nil, congressSuffixes)
+	if err != nil {
+		return 0, fmt.Errorf("invalid congress %q: %s", s, err)
+	}
+	return n, nil
+}
+
+// ParseSession parses a session name such as "1st Session" or
+// "2d Session", as found in Form.SessionName, and returns the number of the
+// session.
+//
+// The same rules apply as for ParseCongress, except that the optional word
+// is "Session" rather than "Congress". The form used in enrolled bills,
+// such as "AT THE FIRST SESSION", is also accepted.
+func ParseSession(s string) (int, error) {
+	n, err := parseOrdinalName(s, sessionPrefixes, sessionSuffixes)
+	if err != nil {
+		return 0, fmt.Errorf("invalid session %q: %s", s, err)
+	}
+	return n, nil
+}
+
+var (
+	congressSuffixes = [][]string{
+		{"congress", "of", "the", "united", "states", "of", "america"},
+		{"congress"},
+	}
+	sessionPrefixes = [][]string{
+		{"at", "the"},
+	}
+	sessionSuffixes = [][]string{
+		{"session"},
+	}
+)
+
+// Congress parses the form's CongressName.
+func (f *Form) Congress() (int, error) {
+	return ParseCongress(f.CongressName)
+}
+
+// Session parses the form's SessionName.
+func (f *Form) Session() (int, error) {
+	return ParseSession(f.SessionName)
+}
+
+// CongressYears returns the calendar years spanned by the given congress.
+//
+// A congress runs for two years, beginning in the odd-numbered year after
+// an election. Since the 74th Congress, each has begun and ended on
+// January 3, so the last few days of each congress fall into the year
+// after the returned last year.
+func CongressYears(congress int) (first, last int) {
+	first = 1789 + (congress-1)*2
+	return first, first + 1
+}
+
+// SessionYear returns the calendar year in which the given session of the
+// given congress began.
+//
+// An error is returned for sessions other than the first and second, and
+// for congresses before the 74th, because in those cases the
+// correspondence between sessions and years is irregular.
+func SessionYear(congress, session int) (int, error) {
+	if congress < 74 {
+		return 0, fmt.Errorf("session years are not regular before the 74th Congress")
+	}
+	first, last := CongressYears(congress)
+	switch session {
+	case 1:
+		return first, nil
+	case 2:
+		return last, nil
+	default:
+		return 0, fmt.Errorf("session %d of the %s Congress has no regular year", session, Ordinal(congress))
+	}
+}
+
+// Ordinal returns the given number as an ordinal in GPO style, such as
+// "1st", "2d", "3d" or "115th".
+func Ordinal(n int) string {
+	return strconv.Itoa(n) + ordinalSuffixes(n)[0]
+}
+
+// ordinalSuffixes returns the suffixes that are valid for the given number
+// when written as an ordinal, with the GPO style first.
+func ordinalSuffixes(n int) []string {
+	if n%100 >= 11 && n%100 <= 13 {
+		return []string{"th"}
+	}
+	switch n % 10 {
+	case 1:
+		return []string{"st"}
+	case 2:
+		return []string{"d", "nd"}
+	case 3:
+		return []string{"d", "rd"}
+	default:
+		return []string{"th"}
+	}
+}
+
+// parseOrdinalName parses an ordinal number, optionally preceded by one of
+// the given sequences of words and optionally followed by another, as used
+// in congress and session names. The ordinal may be written either with
+// digits or in words.
+func parseOrdinalName(s string, prefixes, suffixes [][]string) (int, error) {
+	fields := strings.Fields(strings.ToLower(s))
+	fields = trimWords(fields, prefixes, true)
+	fields = trimWords(fields, suffixes, false)
+	if len(fields) == 0 {
+		return 0, fmt.Errorf("no ordinal number")
+	}
+
+	ord := fields[0]
+	if ord[0] < '0' || ord[0] > '9' {
+		return parseOrdinalWords(fields)
+	}
+	if len(fields) > 1 {
+		return 0, fmt.Errorf("unexpected extra text")
+	}
+
+	end := strings.IndexFunc(ord, func(r rune) bool {
+		return r < '0' || r > '9'
+	})
+	if end < 0 {
+		return 0, fmt.Errorf("no ordinal suffix")
+	}
+	n, err := strconv.Atoi(ord[:end])
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("invalid ordinal number")
+	}
+
+	suffix := ord[end:]
+	for _, valid := range ordinalSuffixes(n) {
+		if suffix == valid {
+			return n, nil
+		}
+	}
+	return 0, fmt.Errorf("incorrect ordinal suffix %q for %d", suffix, n)
+}
+
+// trimWords removes the first of the given sequences of words that appears
+// at the start (or, if prefix is false, the end) of the given fields.
+func trimWords(fields []string, seqs [][]string, prefix bool) []string {
+	for _, seq := range seqs {
+		if len(seq) > len(fields) {
+			continue
+		}
+		if prefix && slices.Equal(fields[:len(seq)], seq) {
+			return fields[len(seq):]
+		}
+		if !prefix && slices.Equal(fields[len(fields)-len(seq):], seq) {
+			return fields[:len(fields)-len(seq)]
+		}
+	}
+	return fields
+}
+
+var cardinalWords = map[string]int{
+	"one": 1, "two": 2, "three": 3, "four": 4, "five": 5,
+	"six": 6, "seven": 7, "eight": 8, "nine": 9, "ten": 10,
+	"eleven": 11, "twelve": 12, "thirteen": 13, "fourteen": 14,
+	"fifteen": 15, "sixteen": 16, "seventeen": 17, "eighteen": 18,
+	"nineteen": 19, "twenty": 20, "thirty": 30, "forty": 40, "fifty": 50,
+	"sixty": 60, "seventy": 70, "eighty": 80, "ninety": 90,
+}
+
+var ordinalWords = map[string]int{
+	"first": 1, "second": 2, "third": 3, "fourth": 4, "fifth": 5,
+	"sixth": 6, "seventh": 7, "eighth": 8, "ninth": 9, "tenth": 10,
+	"eleventh": 11, "twelfth": 12, "thirteenth": 13, "fourteenth": 14,
+	"fifteenth": 15, "sixteenth": 16, "seventeenth": 17, "eighteenth": 18,
+	"nineteenth": 19, "twentieth": 20, "thirtieth": 30, "fortieth": 40,
+	"fiftieth": 50, "sixtieth": 60, "seventieth": 70, "eightieth": 80,
+	"ninetieth": 90,
+}
+
+// parseOrdinalWords parses an ordinal number below one thousand written in
+// words, such as "one hundred twenty-first", given as lowercase fields.
+func parseOrdinalWords(fields []string) (int, error) {
+	var words []string
+	for _, field := range fields {
+		words = append(words, strings.Split(field, "-")...)
+	}
+
+	hundreds, rest := 0, 0
+	last := words[len(words)-1]
+	for _, word := range words[:len(words)-1] {
+		if word == "hundred" {
+			if hundreds != 0 || rest < 1 || rest > 9 {
+				return 0, fmt.Errorf("misplaced %q", word)
+			}
+			hundreds, rest = rest*100, 0
+			continue
+		}
+		v, ok := cardinalWords[word]
+		if !ok || !canFollow(rest, v) {
+			return 0, fmt.Errorf("unexpected %q in ordinal number", word)
+		}
+		rest += v
+	}
+
+	if last == "hundredth" {
+		if hundreds != 0 || rest < 1 || rest > 9 {
+			return 0, fmt.Errorf("misplaced %q", last)
+		}
+		return rest * 100, nil
+	}
+	v, ok := ordinalWords[last]
+	if !ok || !canFollow(rest, v) {
+		return 0, fmt.Errorf("unexpected %q at end of ordinal number", last)
+	}
+	return hundreds + rest + v, nil
+}
+
+// canFollow returns true if a number word with the given value may follow
+// words totalling rest within the tens and units of a number: only a unit
+// may follow, and only after a multiple of ten from twenty upwards.
+func canFollow(rest, v int) bool {
+	return rest == 0 || (rest >= 20 && rest%10 == 0 && v < 10)
+}
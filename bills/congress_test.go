@@ -0,0 +1,169 @@
+package bills
+
+import (
+	"testing"
+)
+
+func TestParseCongress(t *testing.T) {
+	tests := []struct {
+		Input string
+		Want  int
+		Err   bool
+	}{
+		{"115th CONGRESS", 115, false},
+		{"101st Congress", 101, false},
+		{"102d CONGRESS", 102, false},
+		{"103rd CONGRESS", 103, false},
+		{"111th CONGRESS", 111, false},
+		{"112th", 112, false},
+		{"", 0, true},
+		{"CONGRESS", 0, true},
+		{"115st CONGRESS", 0, true},
+		{"111st CONGRESS", 0, true},
+		{"115th SESSION", 0, true},
+		{"115th CONGRESS OF THE UNITED STATES", 0, true},
+		{"One Hundred Fifteenth Congress of the United States of America", 115, false},
+		{"One Hundred Fifteenth Congress", 115, false},
+		{"ONE HUNDRED TWENTY-FIRST CONGRESS", 121, false},
+		{"One Hundred Twenty First Congress", 121, false},
+		{"One Hundred Tenth Congress", 110, false},
+		{"One Hundredth Congress", 100, false},
+		{"Ninety-Ninth Congress", 99, false},
+		{"Second Congress", 2, false},
+		{"115th Congress of the United States of America", 115, false},
+		{"One Hundred Fifteen Congress", 0, true},
+		{"One Hundred Fifteenth Session", 0, true},
+		{"Ten First Congress", 0, true},
+		{"Twenty Twenty-First Congress", 0, true},
+		{"Hundred Fifteenth Congress", 0, true},
+		{"One Hundred Hundredth Congress", 0, true},
+		{"Congress of the United States of America", 0, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Input, func(t *testing.T) {
+			got, err := ParseCongress(test.Input)
+			if test.Err {
+				if err == nil {
+					t.Fatalf("no error; want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("error: %s", err)
+			}
+			if got != test.Want {
+				t.Errorf("got %d; want %d", got, test.Want)
+			}
+		})
+	}
+}
+
+func TestParseSession(t *testing.T) {
+	tests := []struct {
+		Input string
+		Want  int
+		Err   bool
+	}{
+		{"1st Session", 1, false},
+		{"2d Session", 2, false},
+		{"2nd Session", 2, false},
+		{"3d Session", 3, false},
+		{"AT THE FIRST SESSION", 1, false},
+		{"At the Second Session", 2, false},
+		{"at the 2d session", 2, false},
+		{"Third Session", 3, false},
+		{"2th Session", 0, true},
+		{"AT THE SESSION", 0, true},
+		{"AT THE FIRST CONGRESS", 0, true},
+		{"1st CONGRESS", 0, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Input, func(t *testing.T) {
+			got, err := ParseSession(test.Input)
+			if test.Err {
+				if err == nil {
+					t.Fatalf("no error; want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("error: %s", err)
+			}
+			if got != test.Want {
+				t.Errorf("got %d; want %d", got, test.Want)
+			}
+		})
+	}
+}
+
+func TestFormCongressEnrolled(t *testing.T) {
+	input := `<bill bill-stage="Enrolled-Bill">
+<form>
+<congress>One Hundred Fifteenth Congress of the United States of America</congress>
+<session>AT THE FIRST SESSION</session>
+</form>
+</bill>`
+
+	bill, err := ParseBillBuffer([]byte(input))
+	if err != nil {
+		t.Fatalf("error: %s", err)
+	}
+
+	congress, err := bill.Form.Congress()
+	if err != nil {
+		t.Fatalf("error parsing congress: %s", err)
+	}
+	if congress != 115 {
+		t.Errorf("wrong congress %d; want 115", congress)
+	}
+	session, err := bill.Form.Session()
+	if err != nil {
+		t.Fatalf("error parsing session: %s", err)
+	}
+	if session != 1 {
+		t.Errorf("wrong session %d; want 1", session)
+	}
+}
+
+func TestCongressYears(t *testing.T) {
+	first, last := CongressYears(115)
+	if first != 2017 || last != 2018 {
+		t.Errorf("got %d, %d; want 2017, 2018", first, last)
+	}
+
+	year, err := SessionYear(115, 2)
+	if err != nil {
+		t.Fatalf("error: %s", err)
+	}
+	if year != 2018 {
+		t.Errorf("got %d; want 2018", year)
+	}
+
+	if _, err := SessionYear(115, 3); err == nil {
+		t.Errorf("no error for third session; want error")
+	}
+	if _, err := SessionYear(1, 1); err == nil {
+		t.Errorf("no error for first congress; want error")
+	}
+}
+
+func TestOrdinal(t *testing.T) {
+	tests := map[int]string{
+		1:   "1st",
+		2:   "2d",
+		3:   "3d",
+		4:   "4th",
+		11:  "11th",
+		12:  "12th",
+		13:  "13th",
+		102: "102d",
+		115: "115th",
+	}
+	for n, want := range tests {
+		if got := Ordinal(n); got != want {
+			t.Errorf("Ordinal(%d) = %q; want %q", n, got, want)
+		}
+	}
+}
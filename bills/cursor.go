@@ -0,0 +1,125 @@
+package bills
+
+// Cursor describes the position of a walk over structural markup, for
+// visitors that need context beyond the node they are given. A visitor can
+// obtain the cursor for a walk by implementing CursorVisitor.
+//
+// The current element is the one most recently passed to
+// EnterStructuralElement that has not yet been exited, so the calls for its
+// caption, text, blocks and continuation text all see that element as
+// current.
+//
+// Text and blocks that appear directly within the content of a quoted
+// block, rather than within a structural element in that content, are
+// visited with the element containing the quoted block as the current
+// element. InQuotedBlock and QuotedBlock distinguish these from the text
+// and blocks of the element itself.
+type Cursor struct {
+	path    Path
+	indices []int
+	quoted  []*QuotedBlock
+}
+
+func (c *Cursor) push(n Structural, index int) {
+	c.path = append(c.path, n)
+	c.indices = append(c.indices, index)
+}
+
+func (c *Cursor) pop() {
+	c.path = c.path[:len(c.path)-1]
+	c.indices = c.indices[:len(c.indices)-1]
+}
+
+// Node returns the current element, or nil if the walk is not currently
+// within any element.
+func (c *Cursor) Node() Structural {
+	if len(c.path) == 0 {
+		return nil
+	}
+	return c.path[len(c.path)-1]
+}
+
+// Path returns the path from the top of the walk to the current element.
+// The result is a new slice that the caller may retain.
+func (c *Cursor) Path() Path {
+	return append(Path(nil), c.path...)
+}
+
+// Parents returns the elements enclosing the current element, outermost
+// first. The result is a new slice that the caller may retain.
+func (c *Cursor) Parents() Path {
+	if len(c.path) == 0 {
+		return nil
+	}
+	return append(Path(nil), c.path[:len(c.path)-1]...)
+}
+
+// Parent returns the element enclosing the current element, or nil if the
+// current element is at the top of the walk.
+func (c *Cursor) Parent() Structural {
+	return c.path.Parent()
+}
+
+// Depth returns the number of elements enclosing the current element, so
+// that an element at the top of the walk has depth zero.
+//
+// Elements within a quoted block are counted from the top of the walk, not
+// from the start of the quoted block.
+func (c *Cursor) Depth() int {
+	if len(c.path) == 0 {
+		return 0
+	}
+	return len(c.path) - 1
+}
+
+// Index returns the position of the current element within the list that
+// contains it, which is either the child elements of its parent, the
+// content of a quoted block, or the markup being walked.
+//
+// Only structural elements are counted, so any text and blocks that
+// precede an element in the content of a quoted block do not affect its
+// index.
+func (c *Cursor) Index() int {
+	if len(c.indices) == 0 {
+		return 0
+	}
+	return c.indices[len(c.indices)-1]
+}
+
+// Section returns the nearest section that is or encloses the current
+// element, or nil if there is none.
+func (c *Cursor) Section() *Section {
+	for i := len(c.path) - 1; i >= 0; i-- {
+		if sec, ok := c.path[i].(*Section); ok {
+			return sec
+		}
+	}
+	return nil
+}
+
+// Title returns the nearest title that is or encloses the current element,
+// or nil if there is none.
+func (c *Cursor) Title() *Title {
+	for i := len(c.path) - 1; i >= 0; i-- {
+		if title, ok := c.path[i].(*Title); ok {
+			return title
+		}
+	}
+	return nil
+}
+
+// InQuotedBlock returns true if the walk is currently within the content
+// of a quoted block, where the text is to be inserted into another law
+// rather than being part of the document itself.
+func (c *Cursor) InQuotedBlock() bool {
+	return len(c.quoted) != 0
+}
+
+// QuotedBlock returns the innermost quoted block whose content the walk is
+// currently within, or nil if there is none.
+func (c *Cursor) QuotedBlock() *QuotedBlock {
+	if len(c.quoted) == 0 {
+		return nil
+	}
+	return c.quoted[len(c.quoted)-1]
+}
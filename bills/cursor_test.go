@@ -0,0 +1,124 @@
+package bills
+
+import (
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/davecgh/go-spew/spew"
+)
+
+func TestCursor(t *testing.T) {
+	input := `<legis-body>
+<title><enum>I</enum>
+<section><enum>101.</enum><text>Section 5 is amended by adding:</text>
+<quoted-block id="Q1"><subsection><enum>(c)</enum><text>Quoted.</text></subsection></quoted-block>
+<subsection><enum>(a)</enum></subsection>
+<subsection><enum>(b)</enum></subsection>
+</section>
+</title>
+</legis-body>`
+
+	var m StructuralMarkup
+	err := xml.Unmarshal([]byte(input), &m)
+	if err != nil {
+		t.Fatalf("error: %s", err)
+	}
+
+	v := &cursorVisitor{}
+	m.Walk(v)
+
+	want := []string{
+		"I depth=0 index=0 parent=- section=- title=I quoted=-",
+		"101. depth=1 index=0 parent=I section=101. title=I quoted=-",
+		"text of 101.",
+		"(c) depth=2 index=0 parent=101. section=101. title=I quoted=Q1",
+		"text of (c) in Q1",
+		"(a) depth=2 index=0 parent=101. section=101. title=I quoted=-",
+		"(b) depth=2 index=1 parent=101. section=101. title=I quoted=-",
+	}
+	if !reflect.DeepEqual(v.log, want) {
+		t.Errorf("wrong result\ngot:  %s\nwant: %s", spew.Sdump(v.log), spew.Sdump(want))
+	}
+
+	if got := v.Cursor().Node(); got != nil {
+		t.Errorf("cursor still has node %T after walk; want nil", got)
+	}
+}
+
+func TestCursorQuotedContent(t *testing.T) {
+	input := `<legis-body>
+<section><enum>1.</enum><text>Section 5 is amended to read as follows:</text>
+<quoted-block id="Q1">
+<text>Loose text.</text>
+<subsection><enum>(a)</enum><text>First.</text></subsection>
+<text>More loose text.</text>
+<subsection><enum>(b)</enum><text>Second.</text></subsection>
+</quoted-block>
+</section>
+</legis-body>`
+
+	var m StructuralMarkup
+	err := xml.Unmarshal([]byte(input), &m)
+	if err != nil {
+		t.Fatalf("error: %s", err)
+	}
+
+	v := &cursorVisitor{}
+	m.Walk(v)
+
+	want := []string{
+		"1. depth=0 index=0 parent=- section=1. title=- quoted=-",
+		"text of 1.",
+		"text of 1. in Q1",
+		"(a) depth=1 index=0 parent=1. section=1. title=- quoted=Q1",
+		"text of (a) in Q1",
+		"text of 1. in Q1",
+		"(b) depth=1 index=1 parent=1. section=1. title=- quoted=Q1",
+		"text of (b) in Q1",
+	}
+	if !reflect.DeepEqual(v.log, want) {
+		t.Errorf("wrong result\ngot:  %s\nwant: %s", spew.Sdump(v.log), spew.Sdump(want))
+	}
+}
+
+type cursorVisitor struct {
+	StructuralVisitorImpl
+	log []string
+}
+
+func (v *cursorVisitor) EnterStructuralElement(n Structural) StructuralVisitor {
+	c := v.Cursor()
+	enum := func(n Structural) string {
+		return n.Enumerator().Text()
+	}
+	var parent, section, title, quoted string = "-", "-", "-", "-"
+	if p := c.Parent(); p != nil {
+		parent = enum(p)
+	}
+	if s := c.Section(); s != nil {
+		section = enum(s)
+	}
+	if t := c.Title(); t != nil {
+		title = enum(t)
+	}
+	if c.InQuotedBlock() {
+		quoted = c.QuotedBlock().Id
+	}
+	v.log = append(v.log, fmt.Sprintf(
+		"%s depth=%d index=%d parent=%s section=%s title=%s quoted=%s",
+		enum(c.Node()), c.Depth(), c.Index(), parent, section, title, quoted,
+	))
+	return v
+}
+
+func (v *cursorVisitor) EnterText(InlineMarkup) InlineVisitor {
+	c := v.Cursor()
+	entry := "text of " + c.Node().Enumerator().Text()
+	if c.InQuotedBlock() {
+		entry += " in " + c.QuotedBlock().Id
+	}
+	v.log = append(v.log, entry)
+	return nil
+}
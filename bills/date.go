@@ -1,42 +1,262 @@
 package bills
 
 import (
+	"encoding/json"
 	"encoding/xml"
 	"fmt"
+	"regexp"
 	"strconv"
+	"strings"
 	"time"
 )
 
+// Date represents a calendar date, with no associated time or time zone.
 type Date struct {
 	Year  int
 	Month time.Month
 	Day   int
 }
 
+// FromTime returns the date of the given time, in the time's location.
+func FromTime(t time.Time) Date {
+	return Date{
+		Year:  t.Year(),
+		Month: t.Month(),
+		Day:   t.Day(),
+	}
+}
+
+// ParseDate parses a date in the ISO 8601 format YYYY-MM-DD.
+func ParseDate(s string) (Date, error) {
+	if len(s) != 10 || s[4] != '-' || s[7] != '-' {
+		return Date{}, fmt.Errorf("invalid date %q: must be YYYY-MM-DD", s)
+	}
+	return parseDateParts(s, s[0:4], s[5:7], s[8:10])
+}
+
+// parseDateParts parses the given year, month and day strings into a date,
+// returning an error that mentions the given label if any of them are
+// invalid.
+func parseDateParts(label, yearStr, monthStr, dayStr string) (Date, error) {
+	year, err := strconv.Atoi(yearStr)
+	if err != nil {
+		return Date{}, fmt.Errorf("invalid year in %s", label)
+	}
+
+	monthNum, err := strconv.Atoi(monthStr)
+	if err != nil {
+		return Date{}, fmt.Errorf("invalid month in %s", label)
+	}
+
+	day, err := strconv.Atoi(dayStr)
+	if err != nil {
+		return Date{}, fmt.Errorf("invalid day in %s", label)
+	}
+
+	d := Date{
+		Year:  year,
+		Month: time.Month(monthNum),
+		Day:   day,
+	}
+	if err := d.Validate(); err != nil {
+		return Date{}, fmt.Errorf("invalid %s: %s", label, err)
+	}
+	return d, nil
+}
+
+// Valid returns true if the receiver represents a date that exists.
+func (d Date) Valid() bool {
+	return d.Validate() == nil
+}
+
+// Validate returns an error describing why the receiver does not represent
+// a date that exists, or nil if it does.
+func (d Date) Validate() error {
+	if d.Year < 1 || d.Year > 9999 {
+		return fmt.Errorf("year %d is out of range", d.Year)
+	}
+	if d.Month < time.January || d.Month > time.December {
+		return fmt.Errorf("month %d is out of range", int(d.Month))
+	}
+	if d.Day < 1 || d.Day > daysIn(d.Month, d.Year) {
+		return fmt.Errorf("day %d is out of range for %s %d", d.Day, d.Month, d.Year)
+	}
+	return nil
+}
+
+func daysIn(month time.Month, year int) int {
+	// Day zero of the following month normalizes to the last day of the
+	// given month.
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// Time returns the time at the start of the receiving date in UTC.
+func (d Date) Time() time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, time.UTC)
+}
+
+// Compare returns -1 if the receiver is before the given date, 1 if it is
+// after the given date, or zero if they are the same date.
+func (d Date) Compare(other Date) int {
+	switch {
+	case d.Year != other.Year:
+		return compareInts(d.Year, other.Year)
+	case d.Month != other.Month:
+		return compareInts(int(d.Month), int(other.Month))
+	default:
+		return compareInts(d.Day, other.Day)
+	}
+}
+
+func compareInts(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Before returns true if the receiver is before the given date.
+func (d Date) Before(other Date) bool {
+	return d.Compare(other) < 0
+}
+
+// After returns true if the receiver is after the given date.
+func (d Date) After(other Date) bool {
+	return d.Compare(other) > 0
+}
+
+// String returns the date in the ISO 8601 format YYYY-MM-DD.
+func (d Date) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, int(d.Month), d.Day)
+}
+
+// HumanString returns the date in the form used in the text of bills, such
+// as "January 3, 2017".
+func (d Date) HumanString() string {
+	return fmt.Sprintf("%s %d, %d", d.Month, d.Day, d.Year)
+}
+
 func (d *Date) UnmarshalXMLAttr(attr xml.Attr) error {
 	value := attr.Value
 	if len(value) != 8 {
-		return fmt.Errorf("invalid %s value %q", attr.Name, value)
+		return fmt.Errorf("invalid %s value %q", attr.Name.Local, value)
 	}
 
-	year, err := strconv.Atoi(value[0:4])
+	parsed, err := parseDateParts(attr.Name.Local, value[0:4], value[4:6], value[6:8])
 	if err != nil {
-		return fmt.Errorf("invalid year in %s", attr.Name)
+		return err
 	}
+	*d = parsed
+	return nil
+}
 
-	day, err := strconv.Atoi(value[6:8])
+// MarshalXMLAttr produces an attribute in the YYYYMMDD format used for the
+// date attributes in bills. The attribute is omitted for the zero date.
+func (d Date) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if d == (Date{}) {
+		return xml.Attr{}, nil
+	}
+	if err := d.Validate(); err != nil {
+		return xml.Attr{}, fmt.Errorf("invalid %s: %s", name.Local, err)
+	}
+	return xml.Attr{
+		Name:  name,
+		Value: fmt.Sprintf("%04d%02d%02d", d.Year, int(d.Month), d.Day),
+	}, nil
+}
+
+// MarshalJSON produces a JSON string in the ISO 8601 format YYYY-MM-DD, or
+// null for the zero date.
+func (d Date) MarshalJSON() ([]byte, error) {
+	if d == (Date{}) {
+		return []byte("null"), nil
+	}
+	if err := d.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid date: %s", err)
+	}
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON accepts a JSON string in the ISO 8601 format YYYY-MM-DD, or
+// null for the zero date.
+func (d *Date) UnmarshalJSON(buf []byte) error {
+	if string(buf) == "null" {
+		*d = Date{}
+		return nil
+	}
+
+	var raw string
+	err := json.Unmarshal(buf, &raw)
 	if err != nil {
-		return fmt.Errorf("invalid day in %s", attr.Name)
+		return fmt.Errorf("date must be a string")
 	}
 
-	monthNum, err := strconv.Atoi(value[4:6])
+	parsed, err := ParseDate(raw)
 	if err != nil {
-		return fmt.Errorf("invalid month in %s", attr.Name)
+		return err
 	}
+	*d = parsed
+	return nil
+}
 
-	d.Year = year
-	d.Month = time.Month(monthNum)
-	d.Day = day
+var humanDateRe = regexp.MustCompile(`^([A-Za-z]+)\.?\s+(\d{1,2}),?\s+(\d{4})$`)
 
-	return nil
+var monthNames = map[string]time.Month{
+	"jan":  time.January,
+	"feb":  time.February,
+	"mar":  time.March,
+	"apr":  time.April,
+	"may":  time.May,
+	"jun":  time.June,
+	"june": time.June,
+	"jul":  time.July,
+	"july": time.July,
+	"aug":  time.August,
+	"sep":  time.September,
+	"sept": time.September,
+	"oct":  time.October,
+	"nov":  time.November,
+	"dec":  time.December,
+}
+
+// ParseHumanDate parses a date in the form used in the text of bills, such
+// as "January 3, 2017". Abbreviated month names, like "Jan." or "Sept.",
+// are also accepted.
+func ParseHumanDate(s string) (Date, error) {
+	m := humanDateRe.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil {
+		return Date{}, fmt.Errorf("invalid date %q", s)
+	}
+
+	month, ok := parseMonthName(m[1])
+	if !ok {
+		return Date{}, fmt.Errorf("invalid date %q: unknown month %q", s, m[1])
+	}
+	year, _ := strconv.Atoi(m[3])
+	day, _ := strconv.Atoi(m[2])
+
+	d := Date{
+		Year:  year,
+		Month: month,
+		Day:   day,
+	}
+	if err := d.Validate(); err != nil {
+		return Date{}, fmt.Errorf("invalid date %q: %s", s, err)
+	}
+	return d, nil
+}
+
+func parseMonthName(s string) (time.Month, bool) {
+	s = strings.ToLower(s)
+	for m := time.January; m <= time.December; m++ {
+		if s == strings.ToLower(m.String()) {
+			return m, true
+		}
+	}
+	month, ok := monthNames[s]
+	return month, ok
 }
@@ -0,0 +1,151 @@
+package bills
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+	"time"
+)
+
+func TestDateUnmarshalXMLAttr(t *testing.T) {
+	tests := []struct {
+		Input string
+		Want  Date
+		Err   bool
+	}{
+		{"20170103", Date{2017, time.January, 3}, false},
+		{"20160229", Date{2016, time.February, 29}, false},
+		{"20170229", Date{}, true},
+		{"20171301", Date{}, true},
+		{"20170100", Date{}, true},
+		{"2017013", Date{}, true},
+		{"2017-1-3", Date{}, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Input, func(t *testing.T) {
+			var got Date
+			err := got.UnmarshalXMLAttr(xml.Attr{Name: xml.Name{Local: "date"}, Value: test.Input})
+			if test.Err {
+				if err == nil {
+					t.Fatalf("no error; want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("error: %s", err)
+			}
+			if got != test.Want {
+				t.Errorf("got %s; want %s", got, test.Want)
+			}
+		})
+	}
+}
+
+func TestDateConversions(t *testing.T) {
+	d := Date{2017, time.January, 3}
+
+	if got, want := FromTime(d.Time()), d; got != want {
+		t.Errorf("FromTime(d.Time()) = %s; want %s", got, want)
+	}
+
+	attr, err := d.MarshalXMLAttr(xml.Name{Local: "date"})
+	if err != nil {
+		t.Fatalf("MarshalXMLAttr error: %s", err)
+	}
+	if got, want := attr.Value, "20170103"; got != want {
+		t.Errorf("MarshalXMLAttr gave %q; want %q", got, want)
+	}
+
+	buf, err := json.Marshal(d)
+	if err != nil {
+		t.Fatalf("MarshalJSON error: %s", err)
+	}
+	if got, want := string(buf), `"2017-01-03"`; got != want {
+		t.Errorf("MarshalJSON gave %s; want %s", got, want)
+	}
+	var got Date
+	err = json.Unmarshal(buf, &got)
+	if err != nil {
+		t.Fatalf("UnmarshalJSON error: %s", err)
+	}
+	if got != d {
+		t.Errorf("UnmarshalJSON gave %s; want %s", got, d)
+	}
+
+	later := Date{2017, time.February, 1}
+	if !d.Before(later) || d.After(later) || d.Compare(d) != 0 {
+		t.Errorf("incorrect comparison results")
+	}
+
+	if _, err := json.Marshal(Date{2017, 13, 1}); err == nil {
+		t.Errorf("no error marshaling invalid date; want error")
+	}
+
+	buf, err = json.Marshal(Date{})
+	if err != nil {
+		t.Fatalf("MarshalJSON error for zero date: %s", err)
+	}
+	if got, want := string(buf), "null"; got != want {
+		t.Errorf("MarshalJSON gave %s for zero date; want %s", got, want)
+	}
+	attr, err = Date{}.MarshalXMLAttr(xml.Name{Local: "date"})
+	if err != nil {
+		t.Fatalf("MarshalXMLAttr error for zero date: %s", err)
+	}
+	if attr.Name.Local != "" {
+		t.Errorf("MarshalXMLAttr gave %#v for zero date; want no attribute", attr)
+	}
+}
+
+func TestParseHumanDate(t *testing.T) {
+	tests := []struct {
+		Input string
+		Want  Date
+		Err   bool
+	}{
+		{"January 3, 2017", Date{2017, time.January, 3}, false},
+		{"Dec. 22, 2017", Date{2017, time.December, 22}, false},
+		{"Sept. 5, 2018", Date{2018, time.September, 5}, false},
+		{"February 30, 2017", Date{}, true},
+		{"Smarch 3, 2017", Date{}, true},
+		{"January 3", Date{}, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Input, func(t *testing.T) {
+			got, err := ParseHumanDate(test.Input)
+			if test.Err {
+				if err == nil {
+					t.Fatalf("no error; want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("error: %s", err)
+			}
+			if got != test.Want {
+				t.Errorf("got %s; want %s", got, test.Want)
+			}
+		})
+	}
+}
+
+func TestActionDateCheck(t *testing.T) {
+	event := Date{2017, time.January, 5}
+	legis := Date{2017, time.January, 3}
+
+	ad := &ActionDate{
+		HumanReadable:   "January 5 (legislative day, January 3), 2017",
+		EventDate:       &event,
+		LegislativeDate: &legis,
+	}
+	if err := ad.Check(); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	ad.HumanReadable = "January 6, 2017"
+	if err := ad.Check(); err == nil {
+		t.Errorf("no error for mismatched date; want error")
+	}
+}
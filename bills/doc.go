@@ -7,5 +7,7 @@
 // system FDSys or via intermediaries with more convenient access interfaces,
 // such as Govtrack: https://www.govtrack.us/developers/data
 //
-// The main entry points for this package are ParseBill and ParseBillBytes .
+// The main entry points for this package are ParseBill and ParseBillBuffer,
+// for documents known to be bills, and ParseDocument and ParseDocumentBuffer
+// for documents that may be bills, resolutions or amendments.
 package bills
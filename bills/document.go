@@ -0,0 +1,116 @@
+package bills
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+)
+
+// Document is implemented by the types representing each of the different
+// kinds of top-level document that can be published in this format.
+//
+// Use a type switch to find the specific type of document, or use the
+// methods of this interface to access the parts that are common to all
+// document types.
+type Document interface {
+	// DocumentMetadata returns the metadata block of the document, or nil if
+	// it has none.
+	DocumentMetadata() *Metadata
+
+	// DocumentForm returns the form (the cover page information) of the
+	// document, or nil if it has none.
+	DocumentForm() *Form
+
+	// DocumentBody returns the main body of the document, or nil if it has
+	// none.
+	DocumentBody() *Body
+}
+
+func (b *Bill) DocumentMetadata() *Metadata {
+	return b.Metadata
+}
+
+func (b *Bill) DocumentForm() *Form {
+	return b.Form
+}
+
+func (b *Bill) DocumentBody() *Body {
+	return b.Body
+}
+
+func (r *Resolution) DocumentMetadata() *Metadata {
+	return r.Metadata
+}
+
+func (r *Resolution) DocumentForm() *Form {
+	return r.Form
+}
+
+func (r *Resolution) DocumentBody() *Body {
+	return r.Body
+}
+
+func (a *Amendment) DocumentMetadata() *Metadata {
+	return a.Metadata
+}
+
+func (a *Amendment) DocumentForm() *Form {
+	if a.Form == nil {
+		return nil
+	}
+	return &a.Form.Form
+}
+
+// DocumentBody always returns nil for an amendment, because the body of an
+// amendment is not structured in the same way as that of a bill or
+// resolution. Use the Body field to access the amendment body.
+func (a *Amendment) DocumentBody() *Body {
+	return nil
+}
+
+// ParseDocument reads a document of any supported type from the given
+// reader, selecting the appropriate type based on the document's root
+// element.
+//
+// An error is returned if the root element is not of a supported type.
+func ParseDocument(r io.Reader) (Document, error) {
+	decoder := xml.NewDecoder(r)
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			if err == io.EOF {
+				return nil, fmt.Errorf("document has no root element")
+			}
+			return nil, err
+		}
+
+		if start, ok := token.(xml.StartElement); ok {
+			return decodeDocument(decoder, start)
+		}
+	}
+}
+
+// ParseDocumentBuffer is like ParseDocument but reads from a byte slice.
+func ParseDocumentBuffer(buf []byte) (Document, error) {
+	return ParseDocument(bytes.NewReader(buf))
+}
+
+func decodeDocument(d *xml.Decoder, start xml.StartElement) (Document, error) {
+	switch start.Name.Local {
+	case "bill":
+		ret := &Bill{}
+		err := d.DecodeElement(ret, &start)
+		return ret, err
+	case "resolution":
+		ret := &Resolution{}
+		err := d.DecodeElement(ret, &start)
+		return ret, err
+	case "amendment-doc":
+		ret := &Amendment{}
+		err := d.DecodeElement(ret, &start)
+		return ret, err
+	default:
+		return nil, fmt.Errorf("unsupported document type %q", start.Name.Local)
+	}
+}
@@ -0,0 +1,191 @@
+package bills
+
+import (
+	"testing"
+)
+
+func TestParseDocumentResolution(t *testing.T) {
+	input := `<?xml version="1.0"?>
+<resolution resolution-stage="Introduced-in-House" resolution-type="house-resolution" public-private="public">
+<form><legis-num>H. RES. 12</legis-num></form>
+<preamble>
+<whereas><text>Whereas the first clause;</text></whereas>
+<whereas><text>Whereas the second clause: Now, therefore, be it</text></whereas>
+</preamble>
+<resolution-body style="traditional">
+<section><text>That the House of Representatives resolves.</text></section>
+</resolution-body>
+</resolution>`
+
+	doc, err := ParseDocumentBuffer([]byte(input))
+	if err != nil {
+		t.Fatalf("error: %s", err)
+	}
+
+	res, ok := doc.(*Resolution)
+	if !ok {
+		t.Fatalf("got %T; want *Resolution", doc)
+	}
+
+	if got, want := res.Type, HouseSimpleResolution; got != want {
+		t.Errorf("wrong Type %q; want %q", got, want)
+	}
+	if got, want := res.Stage, BillStageIntroducedInHouse; got != want {
+		t.Errorf("wrong Stage %q; want %q", got, want)
+	}
+	if got, want := doc.DocumentForm().LegislationName, "H. RES. 12"; got != want {
+		t.Errorf("wrong LegislationName %q; want %q", got, want)
+	}
+
+	if res.Preamble == nil {
+		t.Fatalf("Preamble is nil")
+	}
+	if got, want := len(res.Preamble.Clauses), 2; got != want {
+		t.Fatalf("wrong number of whereas clauses %d; want %d", got, want)
+	}
+	if got, want := res.Preamble.Clauses[0].Text.Text(), "Whereas the first clause;"; got != want {
+		t.Errorf("wrong first clause %q; want %q", got, want)
+	}
+
+	body := doc.DocumentBody()
+	if body == nil {
+		t.Fatalf("Body is nil")
+	}
+	if got, want := body.StyleCode, StyleTraditional; got != want {
+		t.Errorf("wrong body StyleCode %q; want %q", got, want)
+	}
+	if got, want := len(body.StructuralMarkup), 1; got != want {
+		t.Fatalf("wrong number of body elements %d; want %d", got, want)
+	}
+	if _, ok := body.StructuralMarkup[0].(*Section); !ok {
+		t.Errorf("body element is %T; want *Section", body.StructuralMarkup[0])
+	}
+}
+
+func TestParseDocumentUnsupported(t *testing.T) {
+	_, err := ParseDocumentBuffer([]byte(`<not-a-document/>`))
+	if err == nil {
+		t.Fatalf("no error; want error")
+	}
+}
+
+func TestParseDocumentAmendment(t *testing.T) {
+	input := `<amendment-doc amend-type="senate-amendment" public-private="public">
+<amendment-form>
+<congress>115th CONGRESS</congress>
+<session>1st Session</session>
+<legis-num>H. R. 1</legis-num>
+<amend-num>SA 1618</amend-num>
+<purpose>To strike a section.</purpose>
+</amendment-form>
+<amendment-body>
+<amendment>
+<amendment-instruction><text>Strike section 2 and insert the following:</text></amendment-instruction>
+<amendment-block style="OLC">
+<section><enum>2.</enum><header>Replacement</header><text>New text.</text></section>
+</amendment-block>
+</amendment>
+</amendment-body>
+</amendment-doc>`
+
+	doc, err := ParseDocumentBuffer([]byte(input))
+	if err != nil {
+		t.Fatalf("error: %s", err)
+	}
+
+	amdt, ok := doc.(*Amendment)
+	if !ok {
+		t.Fatalf("got %T; want *Amendment", doc)
+	}
+
+	if got, want := amdt.Type, SenateAmendment; got != want {
+		t.Errorf("wrong Type %q; want %q", got, want)
+	}
+	if got, want := amdt.Form.AmendmentNumber, "SA 1618"; got != want {
+		t.Errorf("wrong AmendmentNumber %q; want %q", got, want)
+	}
+	if got, want := amdt.Form.Purpose.Text(), "To strike a section."; got != want {
+		t.Errorf("wrong Purpose %q; want %q", got, want)
+	}
+	if got, want := doc.DocumentForm().LegislationName, "H. R. 1"; got != want {
+		t.Errorf("wrong LegislationName %q; want %q", got, want)
+	}
+
+	content := amdt.Body.Content
+	if got, want := len(content), 2; got != want {
+		t.Fatalf("wrong number of body content elements %d; want %d", got, want)
+	}
+	instr, ok := content[0].(*AmendmentInstruction)
+	if !ok {
+		t.Fatalf("first content element is %T; want *AmendmentInstruction", content[0])
+	}
+	if got, want := instr.Text.Text(), "Strike section 2 and insert the following:"; got != want {
+		t.Errorf("wrong instruction text %q; want %q", got, want)
+	}
+	block, ok := content[1].(*AmendmentBlock)
+	if !ok {
+		t.Fatalf("second content element is %T; want *AmendmentBlock", content[1])
+	}
+	if got, want := block.StyleCode, StyleOLC; got != want {
+		t.Errorf("wrong block StyleCode %q; want %q", got, want)
+	}
+	if got, want := len(block.Content), 1; got != want {
+		t.Fatalf("wrong number of block content elements %d; want %d", got, want)
+	}
+	if _, ok := block.Content[0].(*Section); !ok {
+		t.Errorf("block content element is %T; want *Section", block.Content[0])
+	}
+}
+
+func TestParseDocumentAmendmentAttestation(t *testing.T) {
+	input := `<amendment-doc amend-type="engrossed-amendment" amend-stage="Engrossed-Amendment-Senate">
+<engrossed-amendment-form>
+<legis-num>H. R. 1</legis-num>
+</engrossed-amendment-form>
+<engrossed-amendment-body>
+<amendment>
+<amendment-instruction><text>Strike all after the enacting clause and insert the following:</text></amendment-instruction>
+</amendment>
+</engrossed-amendment-body>
+<attestation>
+<attestation-group>
+<attestor display="no">Julie E. Adams,</attestor>
+<role>Secretary.</role>
+</attestation-group>
+</attestation>
+<endorsement display="yes">
+<action-date date="20171220">December 20, 2017</action-date>
+<action-desc>Senate amendment to the House bill</action-desc>
+</endorsement>
+</amendment-doc>`
+
+	doc, err := ParseDocumentBuffer([]byte(input))
+	if err != nil {
+		t.Fatalf("error: %s", err)
+	}
+
+	amdt, ok := doc.(*Amendment)
+	if !ok {
+		t.Fatalf("got %T; want *Amendment", doc)
+	}
+	if amdt.Attestation == nil {
+		t.Fatalf("amendment has no attestation")
+	}
+	if got, want := len(amdt.Attestation.Groups), 1; got != want {
+		t.Fatalf("wrong number of attestation groups %d; want %d", got, want)
+	}
+	group := amdt.Attestation.Groups[0]
+	if got, want := group.Attestor, "Julie E. Adams,"; got != want {
+		t.Errorf("wrong Attestor %q; want %q", got, want)
+	}
+	if got, want := group.Role, "Secretary."; got != want {
+		t.Errorf("wrong Role %q; want %q", got, want)
+	}
+
+	if amdt.Endorsement == nil {
+		t.Fatalf("amendment has no endorsement")
+	}
+	if got, want := amdt.Endorsement.Description[0].Text(), "Senate amendment to the House bill"; got != want {
+		t.Errorf("wrong endorsement description %q; want %q", got, want)
+	}
+}
@@ -1,5 +1,9 @@
 package bills
 
+import (
+	"encoding/xml"
+)
+
 type Form struct {
 	DistributionCode   string           `xml:"distribution-code"`
 	CalendarName       string           `xml:"calendar"`
@@ -11,13 +15,109 @@ type Form struct {
 	CurrentChamberName string           `xml:"current-chamber"`
 	Actions            []*Action        `xml:"action"`
 	TypeName           string           `xml:"legis-type"`
+	OfficialTitle      InlineMarkup     `xml:"official-title"`
 }
 
+// AssociatedDoc represents a reference from a bill's form to another
+// document associated with it, such as a committee report or a calendar
+// number.
 type AssociatedDoc struct {
+	InlineMarkup
+	RoleCode string `xml:"role,attr"`
+}
+
+func (n *AssociatedDoc) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	err := decodeXMLAttrs(n, start)
+	if err != nil {
+		return err
+	}
+	return n.InlineMarkup.UnmarshalXML(d, start)
 }
 
 type Action struct {
-	StageCode   string         `xml:"stage,attr"`
+	StageCode   BillStage      `xml:"stage,attr"`
+	Date        *ActionDate    `xml:"action-date"`
 	Description []InlineMarkup `xml:"action-desc"`
 	Instruction []string       `xml:"action-instruction"`
+
+	// Sponsors, Cosponsors, Nonsponsors and Committees are the names found
+	// anywhere inside the action's description elements, in the order they
+	// appear there.
+	Sponsors    []*SponsorName
+	Cosponsors  []*CosponsorName
+	Nonsponsors []*NonsponsorName
+	Committees  []*CommitteeName
+}
+
+func (n *Action) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	*n = Action{}
+	err := decodeXMLAttrs(n, start)
+	if err != nil {
+		return err
+	}
+
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+
+		switch t := token.(type) {
+		case xml.EndElement:
+			// all done!
+			return nil
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "action-date":
+				n.Date = &ActionDate{}
+				err := d.DecodeElement(n.Date, &t)
+				if err != nil {
+					return err
+				}
+			case "action-desc":
+				var desc InlineMarkup
+				err := d.DecodeElement(&desc, &t)
+				if err != nil {
+					return err
+				}
+				n.Description = append(n.Description, desc)
+				n.collectNames(desc)
+			case "action-instruction":
+				var instr string
+				err := d.DecodeElement(&instr, &t)
+				if err != nil {
+					return err
+				}
+				n.Instruction = append(n.Instruction, instr)
+			default:
+				err := d.Skip()
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// collectNames populates the name lists of the receiver with any names
+// found within the given markup.
+func (n *Action) collectNames(m InlineMarkup) {
+	for _, node := range m {
+		switch tn := node.(type) {
+		case *SponsorName:
+			n.Sponsors = append(n.Sponsors, tn)
+		case *CosponsorName:
+			n.Cosponsors = append(n.Cosponsors, tn)
+		case *NonsponsorName:
+			n.Nonsponsors = append(n.Nonsponsors, tn)
+		case *CommitteeName:
+			n.Committees = append(n.Committees, tn)
+		}
+
+		// Names can't contain other names, but they may be nested inside
+		// other markup such as emphasis.
+		if cn := node.ChildNodes(); cn != nil {
+			n.collectNames(cn)
+		}
+	}
 }
@@ -0,0 +1,80 @@
+package bills
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+)
+
+func TestUnmarshalForm(t *testing.T) {
+	input := `<form>
+<distribution-code display="yes">II</distribution-code>
+<congress>115th CONGRESS</congress>
+<session>1st Session</session>
+<legis-num>H. R. 1</legis-num>
+<associated-doc role="report" display="yes">[Report No. 115-409]</associated-doc>
+<current-chamber>IN THE HOUSE OF REPRESENTATIVES</current-chamber>
+<action>
+<action-date date="20171102">November 2, 2017</action-date>
+<action-desc><sponsor name-id="B000755">Mr. Brady of Texas</sponsor> (for himself and <cosponsor name-id="N000181">Mr. Nunes</cosponsor>) introduced the following bill; which was referred to the <committee-name committee-id="HWM00">Committee on Ways and Means</committee-name></action-desc>
+</action>
+<legis-type>A BILL</legis-type>
+<official-title>To provide for reconciliation pursuant to titles II and V of the concurrent resolution on the budget for fiscal year 2018.</official-title>
+</form>`
+
+	var form Form
+	err := xml.Unmarshal([]byte(input), &form)
+	if err != nil {
+		t.Fatalf("error: %s", err)
+	}
+
+	if got, want := form.OfficialTitle.Text(), "To provide for reconciliation pursuant to titles II and V of the concurrent resolution on the budget for fiscal year 2018."; got != want {
+		t.Errorf("wrong OfficialTitle %q; want %q", got, want)
+	}
+
+	if got, want := len(form.AssociatedDocs), 1; got != want {
+		t.Fatalf("wrong number of associated docs %d; want %d", got, want)
+	}
+	if got, want := form.AssociatedDocs[0].RoleCode, "report"; got != want {
+		t.Errorf("wrong associated doc RoleCode %q; want %q", got, want)
+	}
+	if got, want := form.AssociatedDocs[0].Text(), "[Report No. 115-409]"; got != want {
+		t.Errorf("wrong associated doc text %q; want %q", got, want)
+	}
+
+	if got, want := len(form.Actions), 1; got != want {
+		t.Fatalf("wrong number of actions %d; want %d", got, want)
+	}
+	action := form.Actions[0]
+	if action.Date == nil {
+		t.Fatalf("action has no date")
+	}
+	if got, want := action.Date.HumanReadable, "November 2, 2017"; got != want {
+		t.Errorf("wrong action date text %q; want %q", got, want)
+	}
+	if got, want := *action.Date.EventDate, (Date{Year: 2017, Month: time.November, Day: 2}); got != want {
+		t.Errorf("wrong action EventDate %#v; want %#v", got, want)
+	}
+	if action.Date.LegislativeDate != nil {
+		t.Errorf("action has LegislativeDate %#v; want nil", action.Date.LegislativeDate)
+	}
+
+	if got, want := len(action.Sponsors), 1; got != want {
+		t.Fatalf("wrong number of sponsors %d; want %d", got, want)
+	}
+	if got, want := action.Sponsors[0].NameId, "B000755"; got != want {
+		t.Errorf("wrong sponsor NameId %q; want %q", got, want)
+	}
+	if got, want := len(action.Cosponsors), 1; got != want {
+		t.Fatalf("wrong number of cosponsors %d; want %d", got, want)
+	}
+	if got, want := action.Cosponsors[0].NameId, "N000181"; got != want {
+		t.Errorf("wrong cosponsor NameId %q; want %q", got, want)
+	}
+	if got, want := len(action.Committees), 1; got != want {
+		t.Fatalf("wrong number of committees %d; want %d", got, want)
+	}
+	if got, want := action.Committees[0].CommitteeId, "HWM00"; got != want {
+		t.Errorf("wrong CommitteeId %q; want %q", got, want)
+	}
+}
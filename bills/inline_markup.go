@@ -80,7 +80,7 @@ func (m *InlineMarkup) decodeMarkup(d *xml.Decoder, start xml.StartElement) (Inl
 		err := d.DecodeElement(ret, &start)
 		return ret, err
 	case "footnote-ref":
-		ret := &Footnote{}
+		ret := &FootnoteRef{}
 		err := d.DecodeElement(ret, &start)
 		return ret, err
 	case "fraction":
@@ -132,11 +132,11 @@ func (m *InlineMarkup) decodeMarkup(d *xml.Decoder, start xml.StartElement) (Inl
 		err := d.DecodeElement(ret, &start)
 		return ret, err
 	case "superscript":
-		ret := &Subscript{}
+		ret := &Superscript{}
 		err := d.DecodeElement(ret, &start)
 		return ret, err
 	case "term":
-		ret := &Subscript{}
+		ret := &Term{}
 		err := d.DecodeElement(ret, &start)
 		return ret, err
 	default:
@@ -258,8 +258,8 @@ func (n *InternalCrossReference) UnmarshalXML(d *xml.Decoder, start xml.StartEle
 
 type ExternalCrossReference struct {
 	InlineMarkup
-	TargetTypeCode string `xml:"legal-doc,attr"`
-	ParsableCite   string `xml:"parsable-cite,attr"`
+	TargetTypeCode LegalDocType `xml:"legal-doc,attr"`
+	ParsableCite   string       `xml:"parsable-cite,attr"`
 }
 
 func (n *ExternalCrossReference) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
@@ -374,7 +374,11 @@ type FootnoteRef struct {
 }
 
 func (n *FootnoteRef) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
-	return decodeXMLAttrs(n, start)
+	err := decodeXMLAttrs(n, start)
+	if err != nil {
+		return err
+	}
+	return d.Skip()
 }
 
 type OmittedText struct {
@@ -34,9 +34,9 @@ func TestUnmarshalInlineMarkup(t *testing.T) {
 			`<t><not-a-real-element foo="baz">...</not-a-real-element></t>`,
 			InlineMarkup{
 				&UnsupportedInlineElement{
-					Name: xml.Name{"", "not-a-real-element"},
+					Name: xml.Name{Space: "", Local: "not-a-real-element"},
 					Attrs: map[xml.Name]string{
-						xml.Name{"", "foo"}: "baz",
+						xml.Name{Space: "", Local: "foo"}: "baz",
 					},
 					InlineMarkup: InlineMarkup{
 						Text("..."),
@@ -44,6 +44,26 @@ func TestUnmarshalInlineMarkup(t *testing.T) {
 				},
 			},
 		},
+		{
+			`<t><also-not-real a="1" b="2">x <italic>y</italic></also-not-real></t>`,
+			InlineMarkup{
+				&UnsupportedInlineElement{
+					Name: xml.Name{Local: "also-not-real"},
+					Attrs: map[xml.Name]string{
+						{Local: "a"}: "1",
+						{Local: "b"}: "2",
+					},
+					InlineMarkup: InlineMarkup{
+						Text("x "),
+						&Italic{
+							InlineMarkup{
+								Text("y"),
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			"<t>hello <italic>world</italic></t>",
 			InlineMarkup{
@@ -55,6 +75,202 @@ func TestUnmarshalInlineMarkup(t *testing.T) {
 				},
 			},
 		},
+		{
+			"<t><added-phrase>x</added-phrase></t>",
+			InlineMarkup{
+				&AddedPhrase{
+					InlineMarkup{
+						Text("x"),
+					},
+				},
+			},
+		},
+		{
+			"<t><act-name>x</act-name></t>",
+			InlineMarkup{
+				&ActName{
+					InlineMarkup{
+						Text("x"),
+					},
+				},
+			},
+		},
+		{
+			"<t><bold>x</bold></t>",
+			InlineMarkup{
+				&Bold{
+					InlineMarkup{
+						Text("x"),
+					},
+				},
+			},
+		},
+		{
+			"<t><definition>x</definition></t>",
+			InlineMarkup{
+				&Definition{
+					InlineMarkup{
+						Text("x"),
+					},
+				},
+			},
+		},
+		{
+			"<t><deleted-phrase>x</deleted-phrase></t>",
+			InlineMarkup{
+				&DeletedPhrase{
+					InlineMarkup{
+						Text("x"),
+					},
+				},
+			},
+		},
+		{
+			"<t><editorial>x</editorial></t>",
+			InlineMarkup{
+				&Editorial{
+					InlineMarkup{
+						Text("x"),
+					},
+				},
+			},
+		},
+		{
+			"<t><effective-date>x</effective-date></t>",
+			InlineMarkup{
+				&EffectiveDate{
+					InlineMarkup{
+						Text("x"),
+					},
+				},
+			},
+		},
+		{
+			"<t><fraction>x</fraction></t>",
+			InlineMarkup{
+				&Fraction{
+					InlineMarkup{
+						Text("x"),
+					},
+				},
+			},
+		},
+		{
+			"<t><quote>x</quote></t>",
+			InlineMarkup{
+				&InlineQuote{
+					InlineMarkup{
+						Text("x"),
+					},
+				},
+			},
+		},
+		{
+			"<t><short-title>x</short-title></t>",
+			InlineMarkup{
+				&ShortTitle{
+					InlineMarkup{
+						Text("x"),
+					},
+				},
+			},
+		},
+		{
+			"<t><subscript>x</subscript></t>",
+			InlineMarkup{
+				&Subscript{
+					InlineMarkup{
+						Text("x"),
+					},
+				},
+			},
+		},
+		{
+			"<t><superscript>x</superscript></t>",
+			InlineMarkup{
+				&Superscript{
+					InlineMarkup{
+						Text("x"),
+					},
+				},
+			},
+		},
+		{
+			"<t><term>x</term></t>",
+			InlineMarkup{
+				&Term{
+					InlineMarkup{
+						Text("x"),
+					},
+				},
+			},
+		},
+		{
+			`<t><footnote id="F1">x</footnote></t>`,
+			InlineMarkup{
+				&Footnote{
+					InlineMarkup: InlineMarkup{
+						Text("x"),
+					},
+					Id: "F1",
+				},
+			},
+		},
+		{
+			`<t><footnote-ref idref="F1"/></t>`,
+			InlineMarkup{
+				&FootnoteRef{
+					IdRef: "F1",
+				},
+			},
+		},
+		{
+			`<t><internal-xref idref="H1">section 1</internal-xref></t>`,
+			InlineMarkup{
+				&InternalCrossReference{
+					InlineMarkup: InlineMarkup{
+						Text("section 1"),
+					},
+					IdReference: "H1",
+				},
+			},
+		},
+		{
+			`<t><external-xref legal-doc="usc" parsable-cite="usc/26/1">section 1</external-xref></t>`,
+			InlineMarkup{
+				&ExternalCrossReference{
+					InlineMarkup: InlineMarkup{
+						Text("section 1"),
+					},
+					TargetTypeCode: LegalDocUSC,
+					ParsableCite:   "usc/26/1",
+				},
+			},
+		},
+		{
+			`<t><nonsponsor name-id="S000033">Bernie Sanders</nonsponsor></t>`,
+			InlineMarkup{
+				&NonsponsorName{
+					InlineMarkup: InlineMarkup{
+						Text("Bernie Sanders"),
+					},
+					NameId: "S000033",
+				},
+			},
+		},
+		{
+			"<t>a<linebreak/>b<nobreak/>c<omitted-text/>d<pagebreak/></t>",
+			InlineMarkup{
+				Text("a"),
+				&LineBreak{},
+				Text("b"),
+				&NoBreak{},
+				Text("c"),
+				&OmittedText{},
+				Text("d"),
+				&PageBreak{},
+			},
+		},
 		{
 			`<t><sponsor name-id="S000033">Bernie Sanders</sponsor></t>`,
 			InlineMarkup{
@@ -0,0 +1,245 @@
+package bills
+
+// TypedInlineVisitor is a richer alternative to InlineVisitor with a
+// separate method for each inline element type, for callers such as
+// renderers that would otherwise need a type switch in every method.
+//
+// Each Enter method returns true to visit the content of the element,
+// in which case the corresponding Exit method is called afterwards, or
+// false to skip both. The same visitor is used for the content.
+//
+// Elements of types not otherwise covered, including
+// UnsupportedInlineElement and inline types defined in other packages,
+// are passed to EnterOtherInline, ExitOtherInline and VisitOtherInline.
+//
+// Use InlineMarkup.WalkTyped to walk with a TypedInlineVisitor, or
+// NewTypedInlineVisitor to use one where an InlineVisitor is expected,
+// such as when returning from StructuralVisitor.EnterText.
+type TypedInlineVisitor interface {
+	VisitText(Text)
+
+	EnterActName(*ActName) bool
+	ExitActName(*ActName)
+
+	EnterAddedPhrase(*AddedPhrase) bool
+	ExitAddedPhrase(*AddedPhrase)
+
+	EnterBold(*Bold) bool
+	ExitBold(*Bold)
+
+	EnterCommitteeName(*CommitteeName) bool
+	ExitCommitteeName(*CommitteeName)
+
+	EnterCosponsor(*CosponsorName) bool
+	ExitCosponsor(*CosponsorName)
+
+	EnterDefinition(*Definition) bool
+	ExitDefinition(*Definition)
+
+	EnterDeletedPhrase(*DeletedPhrase) bool
+	ExitDeletedPhrase(*DeletedPhrase)
+
+	EnterEditorial(*Editorial) bool
+	ExitEditorial(*Editorial)
+
+	EnterEffectiveDate(*EffectiveDate) bool
+	ExitEffectiveDate(*EffectiveDate)
+
+	EnterExternalXref(*ExternalCrossReference) bool
+	ExitExternalXref(*ExternalCrossReference)
+
+	EnterFootnote(*Footnote) bool
+	ExitFootnote(*Footnote)
+
+	EnterFraction(*Fraction) bool
+	ExitFraction(*Fraction)
+
+	EnterInternalXref(*InternalCrossReference) bool
+	ExitInternalXref(*InternalCrossReference)
+
+	EnterItalic(*Italic) bool
+	ExitItalic(*Italic)
+
+	EnterNonsponsor(*NonsponsorName) bool
+	ExitNonsponsor(*NonsponsorName)
+
+	EnterQuote(*InlineQuote) bool
+	ExitQuote(*InlineQuote)
+
+	EnterShortTitle(*ShortTitle) bool
+	ExitShortTitle(*ShortTitle)
+
+	EnterSponsor(*SponsorName) bool
+	ExitSponsor(*SponsorName)
+
+	EnterSubscript(*Subscript) bool
+	ExitSubscript(*Subscript)
+
+	EnterSuperscript(*Superscript) bool
+	ExitSuperscript(*Superscript)
+
+	EnterTerm(*Term) bool
+	ExitTerm(*Term)
+
+	VisitFootnoteRef(*FootnoteRef)
+	VisitLineBreak(*LineBreak)
+	VisitNoBreak(*NoBreak)
+	VisitOmittedText(*OmittedText)
+	VisitPageBreak(*PageBreak)
+
+	EnterOtherInline(Inline) bool
+	ExitOtherInline(Inline)
+	VisitOtherInline(Inline)
+}
+
+// WalkTyped is like Walk but calls the per-element methods of the given
+// TypedInlineVisitor.
+func (m InlineMarkup) WalkTyped(v TypedInlineVisitor) {
+	m.Walk(NewTypedInlineVisitor(v))
+}
+
+// NewTypedInlineVisitor returns an InlineVisitor that dispatches each call
+// to the corresponding method of the given TypedInlineVisitor.
+func NewTypedInlineVisitor(v TypedInlineVisitor) InlineVisitor {
+	return typedInlineVisitor{v}
+}
+
+type typedInlineVisitor struct {
+	v TypedInlineVisitor
+}
+
+func (a typedInlineVisitor) EnterInlineElement(n Inline) InlineVisitor {
+	var descend bool
+	switch n := n.(type) {
+	case *ActName:
+		descend = a.v.EnterActName(n)
+	case *AddedPhrase:
+		descend = a.v.EnterAddedPhrase(n)
+	case *Bold:
+		descend = a.v.EnterBold(n)
+	case *CommitteeName:
+		descend = a.v.EnterCommitteeName(n)
+	case *CosponsorName:
+		descend = a.v.EnterCosponsor(n)
+	case *Definition:
+		descend = a.v.EnterDefinition(n)
+	case *DeletedPhrase:
+		descend = a.v.EnterDeletedPhrase(n)
+	case *Editorial:
+		descend = a.v.EnterEditorial(n)
+	case *EffectiveDate:
+		descend = a.v.EnterEffectiveDate(n)
+	case *ExternalCrossReference:
+		descend = a.v.EnterExternalXref(n)
+	case *Footnote:
+		descend = a.v.EnterFootnote(n)
+	case *Fraction:
+		descend = a.v.EnterFraction(n)
+	case *InternalCrossReference:
+		descend = a.v.EnterInternalXref(n)
+	case *Italic:
+		descend = a.v.EnterItalic(n)
+	case *NonsponsorName:
+		descend = a.v.EnterNonsponsor(n)
+	case *InlineQuote:
+		descend = a.v.EnterQuote(n)
+	case *ShortTitle:
+		descend = a.v.EnterShortTitle(n)
+	case *SponsorName:
+		descend = a.v.EnterSponsor(n)
+	case *Subscript:
+		descend = a.v.EnterSubscript(n)
+	case *Superscript:
+		descend = a.v.EnterSuperscript(n)
+	case *Term:
+		descend = a.v.EnterTerm(n)
+	default:
+		descend = a.v.EnterOtherInline(n)
+	}
+	if !descend {
+		return nil
+	}
+	return a
+}
+
+func (a typedInlineVisitor) ExitInlineElement(n Inline, _ InlineVisitor) {
+	switch n := n.(type) {
+	case *ActName:
+		a.v.ExitActName(n)
+	case *AddedPhrase:
+		a.v.ExitAddedPhrase(n)
+	case *Bold:
+		a.v.ExitBold(n)
+	case *CommitteeName:
+		a.v.ExitCommitteeName(n)
+	case *CosponsorName:
+		a.v.ExitCosponsor(n)
+	case *Definition:
+		a.v.ExitDefinition(n)
+	case *DeletedPhrase:
+		a.v.ExitDeletedPhrase(n)
+	case *Editorial:
+		a.v.ExitEditorial(n)
+	case *EffectiveDate:
+		a.v.ExitEffectiveDate(n)
+	case *ExternalCrossReference:
+		a.v.ExitExternalXref(n)
+	case *Footnote:
+		a.v.ExitFootnote(n)
+	case *Fraction:
+		a.v.ExitFraction(n)
+	case *InternalCrossReference:
+		a.v.ExitInternalXref(n)
+	case *Italic:
+		a.v.ExitItalic(n)
+	case *NonsponsorName:
+		a.v.ExitNonsponsor(n)
+	case *InlineQuote:
+		a.v.ExitQuote(n)
+	case *ShortTitle:
+		a.v.ExitShortTitle(n)
+	case *SponsorName:
+		a.v.ExitSponsor(n)
+	case *Subscript:
+		a.v.ExitSubscript(n)
+	case *Superscript:
+		a.v.ExitSuperscript(n)
+	case *Term:
+		a.v.ExitTerm(n)
+	default:
+		a.v.ExitOtherInline(n)
+	}
+}
+
+func (a typedInlineVisitor) VisitInlineElement(n Inline) {
+	switch n := n.(type) {
+	case *FootnoteRef:
+		a.v.VisitFootnoteRef(n)
+	case *LineBreak:
+		a.v.VisitLineBreak(n)
+	case *NoBreak:
+		a.v.VisitNoBreak(n)
+	case *OmittedText:
+		a.v.VisitOmittedText(n)
+	case *PageBreak:
+		a.v.VisitPageBreak(n)
+	case *ActName, *AddedPhrase, *Bold, *CommitteeName, *CosponsorName,
+		*Definition, *DeletedPhrase, *Editorial, *EffectiveDate,
+		*ExternalCrossReference, *Footnote, *Fraction,
+		*InternalCrossReference, *Italic, *NonsponsorName,
+		*InlineQuote, *ShortTitle, *SponsorName, *Subscript,
+		*Superscript, *Term:
+		// These element types usually have content, but are visited as
+		// leaves when they happen to have none. We still enter and exit
+		// them so that the visitor sees them consistently.
+		if a.EnterInlineElement(n) != nil {
+			a.ExitInlineElement(n, a)
+		}
+	default:
+		a.v.VisitOtherInline(n)
+	}
+}
+
+func (a typedInlineVisitor) VisitInlineText(t Text) {
+	a.v.VisitText(t)
+}
@@ -0,0 +1,60 @@
+package bills
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+)
+
+func TestInlineMarkupWalkTyped(t *testing.T) {
+	input := `<text>The term <term>widget</term> has the meaning given in <external-xref legal-doc="usc" parsable-cite="usc/15/1">section <bold>1</bold></external-xref><footnote-ref idref="F1"/><not-a-real-element>!</not-a-real-element></text>`
+
+	var m InlineMarkup
+	err := xml.Unmarshal([]byte(input), &m)
+	if err != nil {
+		t.Fatalf("error: %s", err)
+	}
+
+	r := &testRenderer{}
+	m.WalkTyped(r)
+
+	got := r.String()
+	want := `The term "widget" has the meaning given in [section 1](usc/15/1)^F1!`
+	if got != want {
+		t.Errorf("wrong result\ngot:  %s\nwant: %s", got, want)
+	}
+}
+
+// testRenderer implements only the methods of TypedInlineVisitor that it
+// cares about, relying on TypedInlineVisitorImpl to descend into all of the
+// other elements.
+type testRenderer struct {
+	TypedInlineVisitorImpl
+	strings.Builder
+}
+
+func (r *testRenderer) VisitText(t Text) {
+	r.WriteString(string(t))
+}
+
+func (r *testRenderer) EnterTerm(*Term) bool {
+	r.WriteString(`"`)
+	return true
+}
+
+func (r *testRenderer) ExitTerm(*Term) {
+	r.WriteString(`"`)
+}
+
+func (r *testRenderer) EnterExternalXref(*ExternalCrossReference) bool {
+	r.WriteString("[")
+	return true
+}
+
+func (r *testRenderer) ExitExternalXref(n *ExternalCrossReference) {
+	r.WriteString("](" + n.ParsableCite + ")")
+}
+
+func (r *testRenderer) VisitFootnoteRef(n *FootnoteRef) {
+	r.WriteString("^" + n.IdRef)
+}
@@ -0,0 +1,153 @@
+package bills
+
+import (
+	"iter"
+	"slices"
+)
+
+// Path is a sequence of structural elements leading from the top of a tree
+// down to a particular element, with that element last.
+type Path []Structural
+
+// Parent returns the element that contains the last element of the path,
+// or nil if the last element is at the top of the tree.
+func (p Path) Parent() Structural {
+	if len(p) < 2 {
+		return nil
+	}
+	return p[len(p)-2]
+}
+
+// InlinePath is a sequence of the inline elements enclosing a particular
+// node in inline markup, outermost first.
+type InlinePath []Inline
+
+// All returns an iterator over the receiver and all of its descendent
+// structural elements in document order, along with the path to each.
+//
+// Elements within quoted blocks are not included, since they are text to
+// be inserted elsewhere rather than part of the structure of the tree.
+//
+// Each path is a new slice that the caller may retain.
+func (m StructuralMarkup) All() iter.Seq2[Path, Structural] {
+	return func(yield func(Path, Structural) bool) {
+		structuralAll(m, nil, yield)
+	}
+}
+
+func structuralAll(m StructuralMarkup, parent Path, yield func(Path, Structural) bool) bool {
+	for _, n := range m {
+		path := append(slices.Clip(parent), n)
+		if !yield(slices.Clone(path), n) {
+			return false
+		}
+		if !structuralAll(n.ChildElements(), path, yield) {
+			return false
+		}
+	}
+	return true
+}
+
+// Sections returns an iterator over all of the sections in the receiver
+// and its descendents, in the same manner as All.
+func (m StructuralMarkup) Sections() iter.Seq2[Path, *Section] {
+	return func(yield func(Path, *Section) bool) {
+		for path, n := range m.All() {
+			if sec, ok := n.(*Section); ok {
+				if !yield(path, sec) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Descendants returns an iterator over all of the elements of the given
+// level in the receiver and its descendents, in the same manner as All.
+func (m StructuralMarkup) Descendants(kind Level) iter.Seq2[Path, Structural] {
+	return func(yield func(Path, Structural) bool) {
+		for path, n := range m.All() {
+			if LevelOf(n) == kind {
+				if !yield(path, n) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// LevelOf returns the level corresponding to the type of the given
+// structural element, or the empty string for element types that have no
+// corresponding level.
+func LevelOf(n Structural) Level {
+	switch n := n.(type) {
+	case *Division:
+		return LevelDivision
+	case *Subdivision:
+		return LevelSubdivision
+	case *Title:
+		return LevelTitle
+	case *Subtitle:
+		return LevelSubtitle
+	case *Part:
+		return LevelPart
+	case *Subpart:
+		return LevelSubpart
+	case *Chapter:
+		return LevelChapter
+	case *SubChapter:
+		return LevelSubchapter
+	case *Section:
+		return LevelSection
+	case *Subsection:
+		return LevelSubsection
+	case *Paragraph:
+		return LevelParagraph
+	case *Subparagraph:
+		return LevelSubparagraph
+	case *Clause:
+		return LevelClause
+	case *Subclause:
+		return LevelSubclause
+	case *Item:
+		return LevelItem
+	case *Subitem:
+		return LevelSubitem
+	case *AppropriationsMajor:
+		return LevelAppropriationsMajor
+	case *AppropriationsIntermediate:
+		return LevelAppropriationsIntermediate
+	case *AppropriationsSmall:
+		return LevelAppropriationsSmall
+	case *UnsupportedStructuralElement:
+		return Level(n.Name.Local)
+	default:
+		return ""
+	}
+}
+
+// Texts returns an iterator over all of the raw text strings within the
+// receiver in document order, along with the path of inline elements
+// enclosing each one.
+//
+// Each path is a new slice that the caller may retain.
+func (m InlineMarkup) Texts() iter.Seq2[InlinePath, Text] {
+	return func(yield func(InlinePath, Text) bool) {
+		inlineTexts(m, nil, yield)
+	}
+}
+
+func inlineTexts(m InlineMarkup, parent InlinePath, yield func(InlinePath, Text) bool) bool {
+	for _, n := range m {
+		if text, ok := n.(Text); ok {
+			if !yield(slices.Clone(parent), text) {
+				return false
+			}
+			continue
+		}
+		if !inlineTexts(n.ChildNodes(), append(slices.Clip(parent), n), yield) {
+			return false
+		}
+	}
+	return true
+}
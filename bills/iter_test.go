@@ -0,0 +1,129 @@
+package bills
+
+import (
+	"encoding/xml"
+	"reflect"
+	"testing"
+
+	"github.com/davecgh/go-spew/spew"
+)
+
+func TestStructuralMarkupIterators(t *testing.T) {
+	input := `<legis-body>
+<title><enum>I</enum><header>General</header>
+<section><enum>101.</enum><header>Definitions</header>
+<subsection><enum>(a)</enum><header>In general</header></subsection>
+<subsection><enum>(b)</enum><header>Exceptions</header>
+<quoted-block><subsection><enum>(c)</enum><header>Quoted</header></subsection></quoted-block>
+</subsection>
+</section>
+<section><enum>102.</enum><header>Effective date</header></section>
+</title>
+</legis-body>`
+
+	var m StructuralMarkup
+	err := xml.Unmarshal([]byte(input), &m)
+	if err != nil {
+		t.Fatalf("error: %s", err)
+	}
+
+	enums := func(path Path) []string {
+		ret := make([]string, len(path))
+		for i, n := range path {
+			ret[i] = n.Enumerator().Text()
+		}
+		return ret
+	}
+
+	t.Run("All", func(t *testing.T) {
+		var got [][]string
+		for path, n := range m.All() {
+			if path[len(path)-1] != n {
+				t.Errorf("path does not end with the yielded element")
+			}
+			got = append(got, enums(path))
+		}
+		want := [][]string{
+			{"I"},
+			{"I", "101."},
+			{"I", "101.", "(a)"},
+			{"I", "101.", "(b)"},
+			{"I", "102."},
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("wrong result\ngot:  %s\nwant: %s", spew.Sdump(got), spew.Sdump(want))
+		}
+	})
+
+	t.Run("Sections", func(t *testing.T) {
+		var got []string
+		for path, sec := range m.Sections() {
+			if path.Parent() != m[0] {
+				t.Errorf("section %q has wrong parent", sec.Enumerator().Text())
+			}
+			got = append(got, sec.Header().Text())
+		}
+		want := []string{"Definitions", "Effective date"}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("wrong result\ngot:  %s\nwant: %s", spew.Sdump(got), spew.Sdump(want))
+		}
+	})
+
+	t.Run("Descendants", func(t *testing.T) {
+		var got []string
+		for _, n := range m.Descendants(LevelSubsection) {
+			got = append(got, n.Header().Text())
+		}
+		want := []string{"In general", "Exceptions"}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("wrong result\ngot:  %s\nwant: %s", spew.Sdump(got), spew.Sdump(want))
+		}
+	})
+
+	t.Run("break", func(t *testing.T) {
+		count := 0
+		for range m.All() {
+			count++
+			if count == 2 {
+				break
+			}
+		}
+		if count != 2 {
+			t.Errorf("visited %d elements; want 2", count)
+		}
+	})
+}
+
+func TestInlineMarkupTexts(t *testing.T) {
+	input := `<text>See <external-xref legal-doc="usc" parsable-cite="usc/15/1">section <bold>1</bold></external-xref>.</text>`
+
+	var m InlineMarkup
+	err := xml.Unmarshal([]byte(input), &m)
+	if err != nil {
+		t.Fatalf("error: %s", err)
+	}
+
+	type result struct {
+		Path []string
+		Text Text
+	}
+	var got []result
+	for path, text := range m.Texts() {
+		r := result{Text: text}
+		for _, n := range path {
+			r.Path = append(r.Path, reflect.TypeOf(n).String())
+		}
+		got = append(got, r)
+		if text == "1" {
+			break
+		}
+	}
+	want := []result{
+		{nil, "See "},
+		{[]string{"*bills.ExternalCrossReference"}, "section "},
+		{[]string{"*bills.ExternalCrossReference", "*bills.Bold"}, "1"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("wrong result\ngot:  %s\nwant: %s", spew.Sdump(got), spew.Sdump(want))
+	}
+}
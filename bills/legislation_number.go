@@ -0,0 +1,181 @@
+package bills
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// LegislationType represents the kinds of legislation that can be
+// introduced in Congress.
+type LegislationType int
+
+const (
+	UnknownLegislationType LegislationType = iota
+	BillLegislation
+	JointResolutionLegislation
+	ConcurrentResolutionLegislation
+	SimpleResolutionLegislation
+)
+
+func (t LegislationType) String() string {
+	switch t {
+	case BillLegislation:
+		return "bill"
+	case JointResolutionLegislation:
+		return "joint resolution"
+	case ConcurrentResolutionLegislation:
+		return "concurrent resolution"
+	case SimpleResolutionLegislation:
+		return "simple resolution"
+	default:
+		return "unknown"
+	}
+}
+
+// LegislationNumber identifies a bill or resolution within a particular
+// congress, such as H.R. 1234 or S.J.Res. 5.
+type LegislationNumber struct {
+	Chamber Chamber
+	Type    LegislationType
+	Number  int
+}
+
+type legislationPrefix struct {
+	chamber Chamber
+	typ     LegislationType
+}
+
+// legislationPrefixes maps the prefixes of legislation numbers, with all
+// punctuation and spacing removed, to the chamber and type they represent.
+var legislationPrefixes = map[string]legislationPrefix{
+	"HR":      {House, BillLegislation},
+	"S":       {Senate, BillLegislation},
+	"HJRES":   {House, JointResolutionLegislation},
+	"SJRES":   {Senate, JointResolutionLegislation},
+	"HCONRES": {House, ConcurrentResolutionLegislation},
+	"SCONRES": {Senate, ConcurrentResolutionLegislation},
+	"HRES":    {House, SimpleResolutionLegislation},
+	"SRES":    {Senate, SimpleResolutionLegislation},
+}
+
+// ParseLegislationNumber parses a legislation number such as "H. R. 1234",
+// as found in Form.LegislationName.
+//
+// Differences in spacing, punctuation and capitalization are ignored, so
+// this function also accepts the canonical citation forms like "H.R. 1234"
+// and "S.J.Res. 5" and the compact forms like "hr1234" and "sjres5".
+func ParseLegislationNumber(s string) (LegislationNumber, error) {
+	var prefix, digits strings.Builder
+	for _, r := range s {
+		switch {
+		case unicode.IsLetter(r):
+			if digits.Len() != 0 {
+				return LegislationNumber{}, fmt.Errorf("invalid legislation number %q", s)
+			}
+			prefix.WriteRune(unicode.ToUpper(r))
+		case unicode.IsDigit(r):
+			digits.WriteRune(r)
+		case unicode.IsSpace(r) || r == '.' || r == '[' || r == ']':
+			// Ignored
+		default:
+			return LegislationNumber{}, fmt.Errorf("invalid legislation number %q", s)
+		}
+	}
+
+	p, ok := legislationPrefixes[prefix.String()]
+	if !ok {
+		return LegislationNumber{}, fmt.Errorf("invalid legislation number %q: unknown legislation type", s)
+	}
+	num, err := strconv.Atoi(digits.String())
+	if err != nil || num <= 0 {
+		return LegislationNumber{}, fmt.Errorf("invalid legislation number %q: missing number", s)
+	}
+
+	return LegislationNumber{
+		Chamber: p.chamber,
+		Type:    p.typ,
+		Number:  num,
+	}, nil
+}
+
+// TypeCode returns the lowercase code for the chamber and type of the
+// legislation, as used in GovInfo identifiers, such as "hr" or "sjres".
+func (n LegislationNumber) TypeCode() string {
+	var chamber string
+	switch n.Chamber {
+	case House:
+		chamber = "h"
+	case Senate:
+		chamber = "s"
+	default:
+		return ""
+	}
+
+	switch n.Type {
+	case BillLegislation:
+		if n.Chamber == House {
+			return "hr"
+		}
+		return "s"
+	case JointResolutionLegislation:
+		return chamber + "jres"
+	case ConcurrentResolutionLegislation:
+		return chamber + "conres"
+	case SimpleResolutionLegislation:
+		return chamber + "res"
+	default:
+		return ""
+	}
+}
+
+// Code returns the compact form of the legislation number, such as
+// "hr1234".
+func (n LegislationNumber) Code() string {
+	return n.TypeCode() + strconv.Itoa(n.Number)
+}
+
+// PackageID returns the GovInfo package identifier for the given version
+// of the legislation in the given congress, such as "BILLS-115hr1enr".
+func (n LegislationNumber) PackageID(congress int, v Version) string {
+	return "BILLS-" + strconv.Itoa(congress) + n.Code() + string(v)
+}
+
+// String returns the canonical citation form of the legislation number,
+// such as "H.R. 1234" or "S.J.Res. 5".
+func (n LegislationNumber) String() string {
+	var chamber string
+	switch n.Chamber {
+	case House:
+		chamber = "H."
+	case Senate:
+		chamber = "S."
+	default:
+		return "(invalid legislation number)"
+	}
+
+	var prefix string
+	switch n.Type {
+	case BillLegislation:
+		if n.Chamber == House {
+			prefix = "H.R."
+		} else {
+			prefix = "S."
+		}
+	case JointResolutionLegislation:
+		prefix = chamber + "J.Res."
+	case ConcurrentResolutionLegislation:
+		prefix = chamber + "Con.Res."
+	case SimpleResolutionLegislation:
+		prefix = chamber + "Res."
+	default:
+		return "(invalid legislation number)"
+	}
+	return prefix + " " + strconv.Itoa(n.Number)
+}
+
+// LegislationNumber parses the form's LegislationName.
+func (f *Form) LegislationNumber() (LegislationNumber, error) {
+	return ParseLegislationNumber(f.LegislationName)
+}
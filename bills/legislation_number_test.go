@@ -0,0 +1,91 @@
+package bills
+
+import (
+	"testing"
+)
+
+func TestParseLegislationNumber(t *testing.T) {
+	tests := []struct {
+		Input  string
+		Want   LegislationNumber
+		String string
+		Code   string
+	}{
+		{
+			"H. R. 1234",
+			LegislationNumber{House, BillLegislation, 1234},
+			"H.R. 1234",
+			"hr1234",
+		},
+		{
+			"S. 5",
+			LegislationNumber{Senate, BillLegislation, 5},
+			"S. 5",
+			"s5",
+		},
+		{
+			"S. J. RES. 5",
+			LegislationNumber{Senate, JointResolutionLegislation, 5},
+			"S.J.Res. 5",
+			"sjres5",
+		},
+		{
+			"H. CON. RES. 71",
+			LegislationNumber{House, ConcurrentResolutionLegislation, 71},
+			"H.Con.Res. 71",
+			"hconres71",
+		},
+		{
+			"H.RES.12",
+			LegislationNumber{House, SimpleResolutionLegislation, 12},
+			"H.Res. 12",
+			"hres12",
+		},
+		{
+			"[H.R. 1]",
+			LegislationNumber{House, BillLegislation, 1},
+			"H.R. 1",
+			"hr1",
+		},
+		{
+			"sres1",
+			LegislationNumber{Senate, SimpleResolutionLegislation, 1},
+			"S.Res. 1",
+			"sres1",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Input, func(t *testing.T) {
+			got, err := ParseLegislationNumber(test.Input)
+			if err != nil {
+				t.Fatalf("error: %s", err)
+			}
+			if got != test.Want {
+				t.Errorf("wrong result %#v; want %#v", got, test.Want)
+			}
+			if got, want := got.String(), test.String; got != want {
+				t.Errorf("wrong String() %q; want %q", got, want)
+			}
+			if got, want := got.Code(), test.Code; got != want {
+				t.Errorf("wrong Code() %q; want %q", got, want)
+			}
+		})
+	}
+
+	for _, input := range []string{"", "H. R.", "X. 1", "H. R. 1A", "1234"} {
+		t.Run(input, func(t *testing.T) {
+			_, err := ParseLegislationNumber(input)
+			if err == nil {
+				t.Errorf("no error; want error")
+			}
+		})
+	}
+}
+
+func TestLegislationNumberPackageID(t *testing.T) {
+	n := LegislationNumber{House, BillLegislation, 1}
+	if got, want := n.PackageID(115, VersionENR), "BILLS-115hr1enr"; got != want {
+		t.Errorf("wrong PackageID %q; want %q", got, want)
+	}
+}
@@ -0,0 +1,84 @@
+package bills
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+)
+
+// Metadata represents the "metadata" element at the start of a document,
+// which carries information about the document itself rather than about
+// the legislation it contains.
+type Metadata struct {
+	DublinCore *DublinCore `xml:"dublinCore"`
+}
+
+// DublinCore represents the Dublin Core metadata fields that GPO includes
+// in the metadata block of each published document.
+//
+// Fields for elements that are not present in the document are left as
+// their zero values.
+type DublinCore struct {
+	Title     string        `xml:"http://purl.org/dc/elements/1.1/ title"`
+	Publisher string        `xml:"http://purl.org/dc/elements/1.1/ publisher"`
+	Date      *MetadataDate `xml:"http://purl.org/dc/elements/1.1/ date"`
+	Format    string        `xml:"http://purl.org/dc/elements/1.1/ format"`
+	Language  string        `xml:"http://purl.org/dc/elements/1.1/ language"`
+	Rights    string        `xml:"http://purl.org/dc/elements/1.1/ rights"`
+}
+
+// MetadataDate is the date given in the Dublin Core "date" element, which
+// (unlike the date attributes elsewhere in a document) is written in the
+// ISO 8601 format YYYY-MM-DD.
+//
+// Some documents leave the date element empty, in which case Valid is false
+// and the embedded Date is the zero value.
+type MetadataDate struct {
+	Date
+	Valid bool
+}
+
+func (d *MetadataDate) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	var raw string
+	err := dec.DecodeElement(&raw, &start)
+	if err != nil {
+		return err
+	}
+
+	*d = MetadataDate{}
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil
+	}
+
+	date, err := ParseDate(raw)
+	if err != nil {
+		return fmt.Errorf("invalid %s value %q", start.Name.Local, raw)
+	}
+	d.Date = date
+	d.Valid = true
+	return nil
+}
+
+// MarshalJSON produces a JSON string in the ISO 8601 format YYYY-MM-DD, or
+// null if the date is not valid.
+func (d MetadataDate) MarshalJSON() ([]byte, error) {
+	if !d.Valid {
+		return []byte("null"), nil
+	}
+	return d.Date.MarshalJSON()
+}
+
+// UnmarshalJSON accepts the values produced by MarshalJSON.
+func (d *MetadataDate) UnmarshalJSON(buf []byte) error {
+	*d = MetadataDate{}
+	if string(buf) == "null" {
+		return nil
+	}
+	err := d.Date.UnmarshalJSON(buf)
+	if err != nil {
+		return err
+	}
+	d.Valid = true
+	return nil
+}
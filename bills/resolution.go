@@ -0,0 +1,171 @@
+package bills
+
+import (
+	"encoding/xml"
+)
+
+// Resolution represents a simple, concurrent or joint resolution, which
+// differ from bills in that they may have a preamble of "whereas" clauses
+// before the resolving body.
+type Resolution struct {
+	Stage         BillStage      `xml:"resolution-stage,attr"`
+	Type          ResolutionType `xml:"resolution-type,attr"`
+	DMSId         string         `xml:"dms-id,attr"`
+	PublicPrivate PublicPrivate  `xml:"public-private,attr"`
+	StageCount    int            `xml:"stage-count,attr"`
+
+	Metadata *Metadata `xml:"metadata"`
+	Form     *Form     `xml:"form"`
+	Preamble *Preamble `xml:"preamble"`
+	Body     *Body     `xml:"resolution-body"`
+
+	Attestation *Attestation `xml:"attestation"`
+	Endorsement *Endorsement `xml:"endorsement"`
+}
+
+func (r *Resolution) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	*r = Resolution{}
+	err := decodeXMLAttrs(r, start)
+	if err != nil {
+		return err
+	}
+
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+
+		switch t := token.(type) {
+		case xml.EndElement:
+			// all done!
+			return nil
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "metadata":
+				r.Metadata = &Metadata{}
+				err := d.DecodeElement(r.Metadata, &t)
+				if err != nil {
+					return err
+				}
+			case "form":
+				r.Form = &Form{}
+				err := d.DecodeElement(r.Form, &t)
+				if err != nil {
+					return err
+				}
+			case "preamble":
+				r.Preamble = &Preamble{}
+				err := d.DecodeElement(r.Preamble, &t)
+				if err != nil {
+					return err
+				}
+			case "resolution-body":
+				r.Body = &Body{}
+				err := d.DecodeElement(r.Body, &t)
+				if err != nil {
+					return err
+				}
+			case "attestation":
+				r.Attestation = &Attestation{}
+				err := d.DecodeElement(r.Attestation, &t)
+				if err != nil {
+					return err
+				}
+			case "endorsement":
+				r.Endorsement = &Endorsement{}
+				err := d.DecodeElement(r.Endorsement, &t)
+				if err != nil {
+					return err
+				}
+			default:
+				err := d.Skip()
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// ResolutionType is the value of the "resolution-type" attribute on a
+// resolution's root element, which identifies the kind of resolution and
+// the chamber where it originated.
+type ResolutionType string
+
+const (
+	HouseSimpleResolution      ResolutionType = "house-resolution"
+	SenateSimpleResolution     ResolutionType = "senate-resolution"
+	HouseConcurrentResolution  ResolutionType = "house-concurrent"
+	SenateConcurrentResolution ResolutionType = "senate-concurrent"
+	HouseJointResolution       ResolutionType = "house-joint"
+	SenateJointResolution      ResolutionType = "senate-joint"
+)
+
+func (t ResolutionType) String() string {
+	return string(t)
+}
+
+func (t ResolutionType) Known() bool {
+	switch t {
+	case HouseSimpleResolution, SenateSimpleResolution,
+		HouseConcurrentResolution, SenateConcurrentResolution,
+		HouseJointResolution, SenateJointResolution:
+		return true
+	default:
+		return false
+	}
+}
+
+// Preamble represents the sequence of "whereas" clauses that may appear
+// before the body of a resolution, explaining the reasons for it.
+type Preamble struct {
+	Clauses []*Whereas `xml:"whereas"`
+}
+
+// Whereas represents a single clause within a preamble.
+//
+// Whereas clauses can contain block elements, such as quoted blocks, after
+// their text.
+type Whereas struct {
+	Text   InlineMarkup
+	Blocks BlockMarkup
+}
+
+func (n *Whereas) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	*n = Whereas{}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+
+		switch t := token.(type) {
+		case xml.EndElement:
+			// all done!
+			return nil
+		case xml.StartElement:
+			if isBlockElement(t.Name) {
+				obj, err := decodeBlockElement(d, t)
+				if err != nil {
+					return err
+				}
+				n.Blocks = append(n.Blocks, obj)
+				continue
+			}
+
+			switch t.Name.Local {
+			case "text":
+				err := d.DecodeElement(&n.Text, &t)
+				if err != nil {
+					return err
+				}
+			default:
+				err := d.Skip()
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
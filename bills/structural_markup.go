@@ -34,6 +34,18 @@ func (m *StructuralMarkup) UnmarshalXML(d *xml.Decoder, start xml.StartElement)
 
 func decodeStructuralElement(d *xml.Decoder, start xml.StartElement) (Structural, error) {
 	switch start.Name.Local {
+	case "appropriations-major":
+		ret := &AppropriationsMajor{}
+		err := d.DecodeElement(ret, &start)
+		return ret, err
+	case "appropriations-intermediate":
+		ret := &AppropriationsIntermediate{}
+		err := d.DecodeElement(ret, &start)
+		return ret, err
+	case "appropriations-small":
+		ret := &AppropriationsSmall{}
+		err := d.DecodeElement(ret, &start)
+		return ret, err
 	case "chapter":
 		ret := &Chapter{}
 		err := d.DecodeElement(ret, &start)
@@ -67,7 +79,7 @@ func decodeStructuralElement(d *xml.Decoder, start xml.StartElement) (Structural
 		err := d.DecodeElement(ret, &start)
 		return ret, err
 	case "subchapter":
-		ret := &Chapter{}
+		ret := &SubChapter{}
 		err := d.DecodeElement(ret, &start)
 		return ret, err
 	case "subclause":
@@ -87,7 +99,7 @@ func decodeStructuralElement(d *xml.Decoder, start xml.StartElement) (Structural
 		err := d.DecodeElement(ret, &start)
 		return ret, err
 	case "subpart":
-		ret := &Part{}
+		ret := &Subpart{}
 		err := d.DecodeElement(ret, &start)
 		return ret, err
 	case "subsection":
@@ -106,6 +118,29 @@ func decodeStructuralElement(d *xml.Decoder, start xml.StartElement) (Structural
 }
 
 type Structural interface {
+	// ID returns the value of the element's "id" attribute, which can be
+	// used to resolve InternalCrossReference.IdReference and
+	// SimpleTOCEntry.IdRef values, or the empty string if it has none.
+	ID() string
+
+	// SectionType returns the value of the element's "section-type"
+	// attribute, such as "section-one" or "subsequent-section".
+	SectionType() string
+
+	// DisplayInline returns true if the element is marked to be displayed
+	// inline with its parent rather than as a separate block.
+	DisplayInline() bool
+
+	// Commented returns true if the element is marked as commented out,
+	// meaning that it is present in the source but not part of the text.
+	Commented() bool
+
+	// ReportedDisplayStyle returns the value of the element's
+	// "reported-display-style" attribute, which describes how changes
+	// made by a reporting committee should be rendered, such as "italic"
+	// or "strikethrough".
+	ReportedDisplayStyle() string
+
 	Enumerator() InlineMarkup
 	Header() InlineMarkup
 	Text() InlineMarkup
@@ -115,6 +150,12 @@ type Structural interface {
 }
 
 type StructuralElement struct {
+	id                   string
+	sectionType          string
+	displayInline        bool
+	commented            bool
+	reportedDisplayStyle string
+
 	enumerator       InlineMarkup
 	header           InlineMarkup
 	text             InlineMarkup
@@ -123,6 +164,26 @@ type StructuralElement struct {
 	continuationText InlineMarkup
 }
 
+func (m *StructuralElement) ID() string {
+	return m.id
+}
+
+func (m *StructuralElement) SectionType() string {
+	return m.sectionType
+}
+
+func (m *StructuralElement) DisplayInline() bool {
+	return m.displayInline
+}
+
+func (m *StructuralElement) Commented() bool {
+	return m.commented
+}
+
+func (m *StructuralElement) ReportedDisplayStyle() string {
+	return m.reportedDisplayStyle
+}
+
 func (m *StructuralElement) Enumerator() InlineMarkup {
 	return m.enumerator
 }
@@ -144,11 +205,32 @@ func (m *StructuralElement) ChildElements() StructuralMarkup {
 }
 
 func (m *StructuralElement) ContinuationText() InlineMarkup {
-	return m.text
+	return m.continuationText
 }
 
 func (m *StructuralElement) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
 	*m = StructuralElement{}
+
+	// The attribute fields are unexported, so we decode them directly here
+	// rather than via decodeXMLAttrs.
+	for _, attr := range start.Attr {
+		if attr.Name.Space != "" {
+			continue
+		}
+		switch attr.Name.Local {
+		case "id":
+			m.id = attr.Value
+		case "section-type":
+			m.sectionType = attr.Value
+		case "display-inline":
+			m.displayInline = attr.Value == "yes-display-inline"
+		case "commented":
+			m.commented = attr.Value == "yes"
+		case "reported-display-style":
+			m.reportedDisplayStyle = attr.Value
+		}
+	}
+
 	for {
 		token, err := d.Token()
 		if err != nil {
@@ -203,6 +285,39 @@ func (m *StructuralElement) UnmarshalXML(d *xml.Decoder, start xml.StartElement)
 	}
 }
 
+// FindByID searches the receiver and all of its descendent structural
+// elements, including those within quoted blocks, for an element with the
+// given id, returning nil if there is no such element.
+func (m StructuralMarkup) FindByID(id string) Structural {
+	if id == "" {
+		return nil
+	}
+	for _, n := range m {
+		if n.ID() == id {
+			return n
+		}
+		if found := n.ChildElements().FindByID(id); found != nil {
+			return found
+		}
+		for _, block := range n.Blocks() {
+			qb, ok := block.(*QuotedBlock)
+			if !ok {
+				continue
+			}
+			for _, c := range qb.Content {
+				cs, ok := c.(Structural)
+				if !ok {
+					continue
+				}
+				if found := (StructuralMarkup{cs}).FindByID(id); found != nil {
+					return found
+				}
+			}
+		}
+	}
+	return nil
+}
+
 type Chapter struct {
 	StructuralElement
 }
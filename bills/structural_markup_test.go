@@ -0,0 +1,117 @@
+package bills
+
+import (
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestStructuralElementAttributes(t *testing.T) {
+	input := `<legis-body>
+<section id="H1A2B3C" section-type="subsequent-section" display-inline="yes-display-inline" commented="yes" reported-display-style="italic">
+<enum>2.</enum>
+<subsection id="H4D5E6F"><enum>(a)</enum><text>Text.</text></subsection>
+</section>
+</legis-body>`
+
+	var m StructuralMarkup
+	err := xml.Unmarshal([]byte(input), &m)
+	if err != nil {
+		t.Fatalf("error: %s", err)
+	}
+
+	if got, want := len(m), 1; got != want {
+		t.Fatalf("wrong number of elements %d; want %d", got, want)
+	}
+	sec := m[0]
+
+	if got, want := sec.ID(), "H1A2B3C"; got != want {
+		t.Errorf("wrong ID %q; want %q", got, want)
+	}
+	if got, want := sec.SectionType(), "subsequent-section"; got != want {
+		t.Errorf("wrong SectionType %q; want %q", got, want)
+	}
+	if !sec.DisplayInline() {
+		t.Errorf("DisplayInline returned false; want true")
+	}
+	if !sec.Commented() {
+		t.Errorf("Commented returned false; want true")
+	}
+	if got, want := sec.ReportedDisplayStyle(), "italic"; got != want {
+		t.Errorf("wrong ReportedDisplayStyle %q; want %q", got, want)
+	}
+
+	found := m.FindByID("H4D5E6F")
+	if _, ok := found.(*Subsection); !ok {
+		t.Fatalf("FindByID returned %T; want *Subsection", found)
+	}
+	if got, want := found.Enumerator().Text(), "(a)"; got != want {
+		t.Errorf("found element has enumerator %q; want %q", got, want)
+	}
+	if found := m.FindByID("nonexistent"); found != nil {
+		t.Errorf("FindByID returned %T for nonexistent id; want nil", found)
+	}
+}
+
+func TestDecodeStructuralElement(t *testing.T) {
+	tests := []struct {
+		Name string
+		Want Structural
+	}{
+		{"appropriations-major", &AppropriationsMajor{}},
+		{"appropriations-intermediate", &AppropriationsIntermediate{}},
+		{"appropriations-small", &AppropriationsSmall{}},
+		{"chapter", &Chapter{}},
+		{"clause", &Clause{}},
+		{"division", &Division{}},
+		{"item", &Item{}},
+		{"paragraph", &Paragraph{}},
+		{"part", &Part{}},
+		{"section", &Section{}},
+		{"title", &Title{}},
+		{"subchapter", &SubChapter{}},
+		{"subclause", &Subclause{}},
+		{"subdivision", &Subdivision{}},
+		{"subitem", &Subitem{}},
+		{"subparagraph", &Subparagraph{}},
+		{"subpart", &Subpart{}},
+		{"subsection", &Subsection{}},
+		{"subtitle", &Subtitle{}},
+		{"not-a-real-element", &UnsupportedStructuralElement{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			input := fmt.Sprintf(
+				"<legis-body><%s><enum>1.</enum><header>Heading</header><text>Text</text><continuation-text>Continued</continuation-text></%s></legis-body>",
+				test.Name, test.Name,
+			)
+			var m StructuralMarkup
+			err := xml.Unmarshal([]byte(input), &m)
+			if err != nil {
+				t.Fatalf("error: %s", err)
+			}
+			if got, want := len(m), 1; got != want {
+				t.Fatalf("wrong number of elements %d; want %d", got, want)
+			}
+
+			got := m[0]
+			if gotType, wantType := reflect.TypeOf(got), reflect.TypeOf(test.Want); gotType != wantType {
+				t.Errorf("wrong type %s; want %s", gotType, wantType)
+			}
+			if got, want := got.Enumerator().Text(), "1."; got != want {
+				t.Errorf("wrong enumerator %q; want %q", got, want)
+			}
+			if got, want := got.Header().Text(), "Heading"; got != want {
+				t.Errorf("wrong header %q; want %q", got, want)
+			}
+			if got, want := got.Text().Text(), "Text"; got != want {
+				t.Errorf("wrong text %q; want %q", got, want)
+			}
+			if got, want := got.ContinuationText().Text(), "Continued"; got != want {
+				t.Errorf("wrong continuation text %q; want %q", got, want)
+			}
+		})
+	}
+}
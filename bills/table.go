@@ -1,19 +1,344 @@
 package bills
 
+import (
+	"encoding/xml"
+	"fmt"
+)
+
+// TableGroup is a "tgroup" element in a CALS table, which is a run of rows
+// sharing a single column model.
+//
+// The ColSep and RowSep fields of the various table types are nil if the
+// corresponding attribute is absent, in which case the value is inherited
+// from the enclosing element.
 type TableGroup struct {
+	ColumnCount int       `xml:"cols,attr"`
+	Align       Alignment `xml:"align,attr"`
+	ColSep      *bool     `xml:"colsep,attr"`
+	RowSep      *bool     `xml:"rowsep,attr"`
+
 	Columns []*TableColumn `xml:"colspec"`
+	Spans   []*TableSpan   `xml:"spanspec"`
 	Head    *TableRowSeq   `xml:"thead"`
 	Bodies  []*TableRowSeq `xml:"tbody"`
+	Foot    *TableRowSeq   `xml:"tfoot"`
 }
 
+// TableColumn is a "colspec" element, describing one column of a
+// TableGroup.
 type TableColumn struct {
-	// TODO: column model
+	// Number is the one-based position of the column, or zero if the
+	// colspec doesn't specify it, in which case it follows the previous
+	// column.
+	Number int    `xml:"colnum,attr"`
+	Name   string `xml:"colname,attr"`
+
+	// Width is the column width as given in the document, which is either
+	// a fixed measure such as "42pt" or a proportional measure such as
+	// "2*".
+	Width string `xml:"colwidth,attr"`
+
+	Align      Alignment `xml:"align,attr"`
+	Char       string    `xml:"char,attr"`
+	CharOffset string    `xml:"charoff,attr"`
+	ColSep     *bool     `xml:"colsep,attr"`
+	RowSep     *bool     `xml:"rowsep,attr"`
+}
+
+// TableSpan is a "spanspec" element, which gives a name to a horizontal
+// span of columns that entries can then refer to.
+type TableSpan struct {
+	Name       string    `xml:"spanname,attr"`
+	StartName  string    `xml:"namest,attr"`
+	EndName    string    `xml:"nameend,attr"`
+	Align      Alignment `xml:"align,attr"`
+	Char       string    `xml:"char,attr"`
+	CharOffset string    `xml:"charoff,attr"`
+	ColSep     *bool     `xml:"colsep,attr"`
+	RowSep     *bool     `xml:"rowsep,attr"`
 }
 
+// TableRowSeq is a sequence of rows, used for the head, bodies and foot of
+// a TableGroup.
 type TableRowSeq struct {
-	Rows []TableRow `xml:"row"`
+	VAlign VerticalAlignment `xml:"valign,attr"`
+	Rows   []TableRow        `xml:"row"`
 }
 
 type TableRow struct {
-	Entries []InlineMarkup `xml:"entry"`
+	VAlign  VerticalAlignment `xml:"valign,attr"`
+	RowSep  *bool             `xml:"rowsep,attr"`
+	Entries []*TableEntry     `xml:"entry"`
+}
+
+// TableEntry is a single "entry" element within a table row.
+//
+// An entry occupies the column named by ColumnName, or the span of columns
+// given either by StartName and EndName or by SpanName. If none of these
+// are set, it occupies the next free column in its row. MoreRows gives the
+// number of additional rows below this one that the entry extends into.
+type TableEntry struct {
+	InlineMarkup
+
+	ColumnName string            `xml:"colname,attr"`
+	StartName  string            `xml:"namest,attr"`
+	EndName    string            `xml:"nameend,attr"`
+	SpanName   string            `xml:"spanname,attr"`
+	MoreRows   int               `xml:"morerows,attr"`
+	Align      Alignment         `xml:"align,attr"`
+	Char       string            `xml:"char,attr"`
+	CharOffset string            `xml:"charoff,attr"`
+	VAlign     VerticalAlignment `xml:"valign,attr"`
+	ColSep     *bool             `xml:"colsep,attr"`
+	RowSep     *bool             `xml:"rowsep,attr"`
+}
+
+func (n *TableEntry) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	err := decodeXMLAttrs(n, start)
+	if err != nil {
+		return err
+	}
+	return n.InlineMarkup.UnmarshalXML(d, start)
+}
+
+// TableGrid is a rectangular view of a TableGroup produced by its Grid
+// method, with spanning entries resolved to the grid positions they cover.
+type TableGrid struct {
+	// Columns has one element per column in the grid, which is nil for
+	// any column without a corresponding colspec.
+	Columns []*TableColumn
+
+	// Rows contains the rows of the head, then all of the bodies, then the
+	// foot. HeadRows and FootRows give the number of rows at the start and
+	// end that belong to the head and foot respectively.
+	Rows     [][]GridCell
+	HeadRows int
+	FootRows int
+}
+
+// GridCell is a single position in a TableGrid.
+type GridCell struct {
+	// Entry is the entry covering this position, or nil if the position
+	// is empty. An entry that spans multiple rows or columns appears in
+	// every position it covers.
+	Entry *TableEntry
+
+	// Origin is true only for the top-left position of the entry, which
+	// is where a renderer should place it.
+	Origin bool
+
+	// RowSpan and ColSpan give the size of the entry in grid positions.
+	RowSpan, ColSpan int
+
+	// Align is the horizontal alignment of the entry after inheritance from
+	// its span, column and group has been applied.
+	Align Alignment
+}
+
+// Grid resolves the column model and the spans of the entries in the
+// receiver to produce a rectangular grid.
+//
+// An error is returned if an entry refers to a column or span that is not
+// defined, if two entries overlap, or if an entry extends beyond the
+// columns of the group or the rows of its head, body or foot.
+func (g *TableGroup) Grid() (*TableGrid, error) {
+	b := &gridBuilder{
+		group:     g,
+		colIndex:  map[string]int{},
+		spans:     map[string]*TableSpan{},
+		fixedCols: g.ColumnCount > 0,
+	}
+
+	next := 0
+	for _, col := range g.Columns {
+		idx := next
+		if col.Number > 0 {
+			idx = col.Number - 1
+		}
+		if b.fixedCols && idx >= g.ColumnCount {
+			return nil, fmt.Errorf("colspec %q is beyond the %d columns of its group", col.Name, g.ColumnCount)
+		}
+		for len(b.columns) <= idx {
+			b.columns = append(b.columns, nil)
+		}
+		b.columns[idx] = col
+		if col.Name != "" {
+			b.colIndex[col.Name] = idx
+		}
+		next = idx + 1
+	}
+	for len(b.columns) < g.ColumnCount {
+		b.columns = append(b.columns, nil)
+	}
+	for _, span := range g.Spans {
+		b.spans[span.Name] = span
+	}
+
+	ret := &TableGrid{}
+	if g.Head != nil {
+		err := b.addRows(g.Head)
+		if err != nil {
+			return nil, err
+		}
+		ret.HeadRows = len(b.rows)
+	}
+	for _, body := range g.Bodies {
+		err := b.addRows(body)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if g.Foot != nil {
+		before := len(b.rows)
+		err := b.addRows(g.Foot)
+		if err != nil {
+			return nil, err
+		}
+		ret.FootRows = len(b.rows) - before
+	}
+
+	for i := range b.rows {
+		for len(b.rows[i]) < len(b.columns) {
+			b.rows[i] = append(b.rows[i], GridCell{})
+		}
+	}
+	ret.Columns = b.columns
+	ret.Rows = b.rows
+	return ret, nil
+}
+
+type gridBuilder struct {
+	group     *TableGroup
+	columns   []*TableColumn
+	colIndex  map[string]int
+	spans     map[string]*TableSpan
+	fixedCols bool
+	rows      [][]GridCell
+}
+
+func (b *gridBuilder) addRows(seq *TableRowSeq) error {
+	first := len(b.rows)
+	for range seq.Rows {
+		b.rows = append(b.rows, nil)
+	}
+
+	for i, row := range seq.Rows {
+		r := first + i
+		col := 0
+		for _, entry := range row.Entries {
+			start, end, span, err := b.entryColumns(entry, r, col)
+			if err != nil {
+				return err
+			}
+			if entry.MoreRows < 0 || r+entry.MoreRows >= len(b.rows) {
+				return fmt.Errorf("entry in row %d extends beyond the last row of its section", r+1)
+			}
+
+			cell := GridCell{
+				Entry:   entry,
+				RowSpan: entry.MoreRows + 1,
+				ColSpan: end - start + 1,
+				Align:   b.entryAlign(entry, span, start),
+			}
+			for cr := r; cr <= r+entry.MoreRows; cr++ {
+				for cc := start; cc <= end; cc++ {
+					b.extend(cr, cc)
+					if b.rows[cr][cc].Entry != nil {
+						return fmt.Errorf("entry in row %d overlaps another entry at row %d, column %d", r+1, cr+1, cc+1)
+					}
+					c := cell
+					c.Origin = cr == r && cc == start
+					b.rows[cr][cc] = c
+				}
+			}
+			col = end + 1
+		}
+	}
+	return nil
+}
+
+// entryColumns determines the first and last zero-based columns occupied
+// by the given entry, which appears in row r after the column col.
+func (b *gridBuilder) entryColumns(entry *TableEntry, r, col int) (start, end int, span *TableSpan, err error) {
+	switch {
+	case entry.StartName != "":
+		start, err = b.column(entry.StartName, r)
+		if err != nil {
+			return 0, 0, nil, err
+		}
+		end = start
+		if entry.EndName != "" {
+			end, err = b.column(entry.EndName, r)
+			if err != nil {
+				return 0, 0, nil, err
+			}
+		}
+	case entry.SpanName != "":
+		span = b.spans[entry.SpanName]
+		if span == nil {
+			return 0, 0, nil, fmt.Errorf("entry in row %d refers to undefined span %q", r+1, entry.SpanName)
+		}
+		start, err = b.column(span.StartName, r)
+		if err != nil {
+			return 0, 0, nil, err
+		}
+		end, err = b.column(span.EndName, r)
+		if err != nil {
+			return 0, 0, nil, err
+		}
+	case entry.ColumnName != "":
+		start, err = b.column(entry.ColumnName, r)
+		if err != nil {
+			return 0, 0, nil, err
+		}
+		end = start
+	default:
+		// The next column not already occupied by an entry from a
+		// previous row.
+		start = col
+		for start < len(b.rows[r]) && b.rows[r][start].Entry != nil {
+			start++
+		}
+		end = start
+	}
+
+	if end < start {
+		return 0, 0, nil, fmt.Errorf("entry in row %d ends before it starts", r+1)
+	}
+	if b.fixedCols && end >= b.group.ColumnCount {
+		return 0, 0, nil, fmt.Errorf("entry in row %d is beyond the %d columns of its group", r+1, b.group.ColumnCount)
+	}
+	return start, end, span, nil
+}
+
+func (b *gridBuilder) column(name string, r int) (int, error) {
+	idx, ok := b.colIndex[name]
+	if !ok {
+		return 0, fmt.Errorf("entry in row %d refers to undefined column %q", r+1, name)
+	}
+	return idx, nil
+}
+
+func (b *gridBuilder) entryAlign(entry *TableEntry, span *TableSpan, col int) Alignment {
+	if entry.Align != "" {
+		return entry.Align
+	}
+	if span != nil && span.Align != "" {
+		return span.Align
+	}
+	if col < len(b.columns) && b.columns[col] != nil && b.columns[col].Align != "" {
+		return b.columns[col].Align
+	}
+	return b.group.Align
+}
+
+// extend ensures that the grid has a position at row r, column c, adding
+// columns as needed.
+func (b *gridBuilder) extend(r, c int) {
+	for len(b.columns) <= c {
+		b.columns = append(b.columns, nil)
+	}
+	for len(b.rows[r]) <= c {
+		b.rows[r] = append(b.rows[r], GridCell{})
+	}
 }
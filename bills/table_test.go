@@ -0,0 +1,129 @@
+package bills
+
+import (
+	"encoding/xml"
+	"testing"
+)
+
+func TestTableGrid(t *testing.T) {
+	input := `<table frame="topbot" colsep="0" rowsep="1" table-type="">
+<tgroup cols="3" align="left">
+<colspec colname="1" colwidth="120pt"/>
+<colspec colname="2" colwidth="1*" align="right"/>
+<colspec colname="3" colwidth="1*" align="right"/>
+<spanspec spanname="amounts" namest="2" nameend="3" align="center"/>
+<thead>
+<row><entry morerows="1">Account</entry><entry spanname="amounts">Amount</entry></row>
+<row><entry colname="2">2018</entry><entry>2019</entry></row>
+</thead>
+<tbody>
+<row><entry>Salaries</entry><entry>$1,000</entry><entry align="left">$1,100</entry></row>
+<row><entry namest="1" nameend="3">Total</entry></row>
+</tbody>
+</tgroup>
+</table>`
+
+	var table Table
+	err := xml.Unmarshal([]byte(input), &table)
+	if err != nil {
+		t.Fatalf("error: %s", err)
+	}
+
+	if got, want := table.Frame, FrameTopBot; got != want {
+		t.Errorf("wrong Frame %q; want %q", got, want)
+	}
+	if table.ColSep == nil || *table.ColSep {
+		t.Errorf("wrong ColSep %#v; want false", table.ColSep)
+	}
+	if table.RowSep == nil || !*table.RowSep {
+		t.Errorf("wrong RowSep %#v; want true", table.RowSep)
+	}
+
+	group := table.Groups[0]
+	if got, want := group.Columns[0].Width, "120pt"; got != want {
+		t.Errorf("wrong first column width %q; want %q", got, want)
+	}
+	if got, want := group.Spans[0].Name, "amounts"; got != want {
+		t.Errorf("wrong span name %q; want %q", got, want)
+	}
+
+	grid, err := group.Grid()
+	if err != nil {
+		t.Fatalf("error building grid: %s", err)
+	}
+	if got, want := len(grid.Columns), 3; got != want {
+		t.Fatalf("wrong number of columns %d; want %d", got, want)
+	}
+	if got, want := grid.HeadRows, 2; got != want {
+		t.Errorf("wrong HeadRows %d; want %d", got, want)
+	}
+
+	// Each position is summarized as the entry text, with a trailing "*"
+	// for the entry's origin, and the resolved alignment.
+	type cellSummary struct {
+		Text  string
+		Align Alignment
+	}
+	want := [][]cellSummary{
+		{{"Account*", AlignLeft}, {"Amount*", AlignCenter}, {"Amount", AlignCenter}},
+		{{"Account", AlignLeft}, {"2018*", AlignRight}, {"2019*", AlignRight}},
+		{{"Salaries*", AlignLeft}, {"$1,000*", AlignRight}, {"$1,100*", AlignLeft}},
+		{{"Total*", AlignLeft}, {"Total", AlignLeft}, {"Total", AlignLeft}},
+	}
+	if got, want := len(grid.Rows), len(want); got != want {
+		t.Fatalf("wrong number of rows %d; want %d", got, want)
+	}
+	for r, row := range grid.Rows {
+		for c, cell := range row {
+			got := cellSummary{Align: cell.Align}
+			if cell.Entry != nil {
+				got.Text = cell.Entry.Text()
+			}
+			if cell.Origin {
+				got.Text += "*"
+			}
+			if got != want[r][c] {
+				t.Errorf("wrong cell at row %d, column %d: %#v; want %#v", r+1, c+1, got, want[r][c])
+			}
+		}
+	}
+
+	if got := grid.Rows[0][0]; got.RowSpan != 2 || got.ColSpan != 1 {
+		t.Errorf("wrong span for Account %dx%d; want 2x1", got.RowSpan, got.ColSpan)
+	}
+	if got := grid.Rows[3][1]; got.RowSpan != 1 || got.ColSpan != 3 {
+		t.Errorf("wrong span for Total %dx%d; want 1x3", got.RowSpan, got.ColSpan)
+	}
+}
+
+func TestTableGridErrors(t *testing.T) {
+	tests := map[string]string{
+		"overlap": `<tgroup cols="2"><colspec colname="1"/><colspec colname="2"/><tbody>
+<row><entry morerows="1">A</entry><entry>B</entry></row>
+<row><entry colname="1">C</entry></row>
+</tbody></tgroup>`,
+		"undefined column": `<tgroup cols="2"><tbody>
+<row><entry colname="nope">A</entry></row>
+</tbody></tgroup>`,
+		"too many entries": `<tgroup cols="1"><tbody>
+<row><entry>A</entry><entry>B</entry></row>
+</tbody></tgroup>`,
+		"beyond last row": `<tgroup cols="1"><tbody>
+<row><entry morerows="1">A</entry></row>
+</tbody></tgroup>`,
+	}
+
+	for name, input := range tests {
+		t.Run(name, func(t *testing.T) {
+			var group TableGroup
+			err := xml.Unmarshal([]byte(input), &group)
+			if err != nil {
+				t.Fatalf("error: %s", err)
+			}
+			_, err = group.Grid()
+			if err == nil {
+				t.Errorf("Grid succeeded; want error")
+			}
+		})
+	}
+}
@@ -59,9 +59,9 @@ func decodeTOCEntry(d *xml.Decoder, start xml.StartElement) (TOCEntry, error) {
 }
 
 type SimpleTOCEntry struct {
-	BoldCode  string `xml:"bold,attr"`
+	BoldCode  Toggle `xml:"bold,attr"`
 	IdRef     string `xml:"idref,attr"`
-	LevelCode string `xml:"level,attr"`
+	LevelCode Level  `xml:"level,attr"`
 	Header    InlineMarkup
 }
 
@@ -88,8 +88,8 @@ func (e *MultiColumnTOCEntry) TOCEntry() TOCEntry {
 }
 
 type QuotedSimpleTOCEntry struct {
-	StyleCode string `xml:"style,attr"`
-	Entry     *SimpleTOCEntry
+	StyleCode Style           `xml:"style,attr"`
+	Entry     *SimpleTOCEntry `xml:"toc-entry"`
 }
 
 func (e *QuotedSimpleTOCEntry) TOCEntry() TOCEntry {
@@ -97,8 +97,8 @@ func (e *QuotedSimpleTOCEntry) TOCEntry() TOCEntry {
 }
 
 type QuotedMultiColumnTOCEntry struct {
-	StyleCode string `xml:"style,attr"`
-	Entry     *MultiColumnTOCEntry
+	StyleCode Style                `xml:"style,attr"`
+	Entry     *MultiColumnTOCEntry `xml:"multi-column-toc-entry"`
 }
 
 func (e *QuotedMultiColumnTOCEntry) TOCEntry() TOCEntry {
@@ -0,0 +1,294 @@
+package bills
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// Version is a code identifying a text version of a bill or resolution, as
+// used by GPO in the names of published documents. For example, the
+// version as introduced in the House is "ih" and the enrolled version is
+// "enr".
+type Version string
+
+const (
+	VersionAS   Version = "as"
+	VersionASH  Version = "ash"
+	VersionATH  Version = "ath"
+	VersionATS  Version = "ats"
+	VersionCDH  Version = "cdh"
+	VersionCDS  Version = "cds"
+	VersionCPH  Version = "cph"
+	VersionCPS  Version = "cps"
+	VersionEAH  Version = "eah"
+	VersionEAS  Version = "eas"
+	VersionEH   Version = "eh"
+	VersionEHR  Version = "ehr"
+	VersionENR  Version = "enr"
+	VersionEPH  Version = "eph"
+	VersionES   Version = "es"
+	VersionFAH  Version = "fah"
+	VersionFPH  Version = "fph"
+	VersionFPS  Version = "fps"
+	VersionHDH  Version = "hdh"
+	VersionHDS  Version = "hds"
+	VersionIH   Version = "ih"
+	VersionIPH  Version = "iph"
+	VersionIPS  Version = "ips"
+	VersionIS   Version = "is"
+	VersionLTH  Version = "lth"
+	VersionLTS  Version = "lts"
+	VersionOPH  Version = "oph"
+	VersionOPS  Version = "ops"
+	VersionPAP  Version = "pap"
+	VersionPAV  Version = "pav"
+	VersionPCH  Version = "pch"
+	VersionPCS  Version = "pcs"
+	VersionPP   Version = "pp"
+	VersionPWAH Version = "pwah"
+	VersionRAH  Version = "rah"
+	VersionRAS  Version = "ras"
+	VersionRCH  Version = "rch"
+	VersionRCS  Version = "rcs"
+	VersionRDH  Version = "rdh"
+	VersionRDS  Version = "rds"
+	VersionRE   Version = "re"
+	VersionREAH Version = "reah"
+	VersionRENR Version = "renr"
+	VersionRES  Version = "res"
+	VersionRFH  Version = "rfh"
+	VersionRFS  Version = "rfs"
+	VersionRH   Version = "rh"
+	VersionRIH  Version = "rih"
+	VersionRIS  Version = "ris"
+	VersionRS   Version = "rs"
+	VersionRTH  Version = "rth"
+	VersionRTS  Version = "rts"
+	VersionSAS  Version = "sas"
+	VersionSC   Version = "sc"
+)
+
+// The phases of the legislative process, used to order versions. Versions
+// in the same phase have no meaningful order relative to one another.
+const (
+	phaseUnknown = iota
+	phaseIntroduced
+	phaseCommittee
+	phaseReported
+	phaseCalendar
+	phaseFirstChamber
+	phaseSecondChamber
+	phaseAmendment
+	phaseEnrolled
+)
+
+type versionInfo struct {
+	name    string
+	chamber Chamber
+	phase   int
+}
+
+var versionInfos = map[Version]versionInfo{
+	VersionAS:   {"Amendment Ordered to be Printed (Senate)", Senate, phaseAmendment},
+	VersionASH:  {"Additional Sponsors (House)", House, phaseIntroduced},
+	VersionATH:  {"Agreed to (House)", House, phaseFirstChamber},
+	VersionATS:  {"Agreed to (Senate)", Senate, phaseFirstChamber},
+	VersionCDH:  {"Committee Discharged (House)", House, phaseCommittee},
+	VersionCDS:  {"Committee Discharged (Senate)", Senate, phaseCommittee},
+	VersionCPH:  {"Considered and Passed (House)", House, phaseFirstChamber},
+	VersionCPS:  {"Considered and Passed (Senate)", Senate, phaseFirstChamber},
+	VersionEAH:  {"Engrossed Amendment (House)", House, phaseAmendment},
+	VersionEAS:  {"Engrossed Amendment (Senate)", Senate, phaseAmendment},
+	VersionEH:   {"Engrossed in House", House, phaseFirstChamber},
+	VersionEHR:  {"Engrossed in House-Reprint", House, phaseFirstChamber},
+	VersionENR:  {"Enrolled Bill", NoChamber, phaseEnrolled},
+	VersionEPH:  {"Engrossed and Deemed Passed by House", House, phaseFirstChamber},
+	VersionES:   {"Engrossed in Senate", Senate, phaseFirstChamber},
+	VersionFAH:  {"Failed Amendment (House)", House, phaseAmendment},
+	VersionFPH:  {"Failed Passage (House)", House, phaseFirstChamber},
+	VersionFPS:  {"Failed Passage (Senate)", Senate, phaseFirstChamber},
+	VersionHDH:  {"Held at Desk (House)", House, phaseCalendar},
+	VersionHDS:  {"Held at Desk (Senate)", Senate, phaseCalendar},
+	VersionIH:   {"Introduced in House", House, phaseIntroduced},
+	VersionIPH:  {"Indefinitely Postponed (House)", House, phaseFirstChamber},
+	VersionIPS:  {"Indefinitely Postponed (Senate)", Senate, phaseFirstChamber},
+	VersionIS:   {"Introduced in Senate", Senate, phaseIntroduced},
+	VersionLTH:  {"Laid on Table (House)", House, phaseFirstChamber},
+	VersionLTS:  {"Laid on Table (Senate)", Senate, phaseFirstChamber},
+	VersionOPH:  {"Ordered to be Printed (House)", House, phaseCalendar},
+	VersionOPS:  {"Ordered to be Printed (Senate)", Senate, phaseCalendar},
+	VersionPAP:  {"Printed as Passed", NoChamber, phaseFirstChamber},
+	VersionPAV:  {"Previous Action Vitiated", NoChamber, phaseUnknown},
+	VersionPCH:  {"Placed on Calendar (House)", House, phaseCalendar},
+	VersionPCS:  {"Placed on Calendar (Senate)", Senate, phaseCalendar},
+	VersionPP:   {"Public Print", NoChamber, phaseSecondChamber},
+	VersionPWAH: {"Ordered to be Printed with House Amendment", House, phaseAmendment},
+	VersionRAH:  {"Referred with Amendments (House)", House, phaseReported},
+	VersionRAS:  {"Referred with Amendments (Senate)", Senate, phaseReported},
+	VersionRCH:  {"Reference Change (House)", House, phaseCommittee},
+	VersionRCS:  {"Reference Change (Senate)", Senate, phaseCommittee},
+	VersionRDH:  {"Received in House", House, phaseSecondChamber},
+	VersionRDS:  {"Received in Senate", Senate, phaseSecondChamber},
+	VersionRE:   {"Reprint of an Amendment", NoChamber, phaseAmendment},
+	VersionREAH: {"Re-engrossed Amendment (House)", House, phaseAmendment},
+	VersionRENR: {"Re-enrolled Bill", NoChamber, phaseEnrolled},
+	VersionRES:  {"Re-engrossed Amendment (Senate)", Senate, phaseAmendment},
+	VersionRFH:  {"Referred in House", House, phaseSecondChamber},
+	VersionRFS:  {"Referred in Senate", Senate, phaseSecondChamber},
+	VersionRH:   {"Reported in House", House, phaseReported},
+	VersionRIH:  {"Referral Instructions (House)", House, phaseCommittee},
+	VersionRIS:  {"Referral Instructions (Senate)", Senate, phaseCommittee},
+	VersionRS:   {"Reported in Senate", Senate, phaseReported},
+	VersionRTH:  {"Referred to Committee (House)", House, phaseCommittee},
+	VersionRTS:  {"Referred to Committee (Senate)", Senate, phaseCommittee},
+	VersionSAS:  {"Additional Sponsors (Senate)", Senate, phaseIntroduced},
+	VersionSC:   {"Sponsor Change", NoChamber, phaseIntroduced},
+}
+
+var stageVersions = map[BillStage]Version{
+	BillStageAdditionalSponsorsHouse:    VersionASH,
+	BillStageAdditionalSponsorsSenate:   VersionSAS,
+	BillStageAgreedToHouse:              VersionATH,
+	BillStageAgreedToSenate:             VersionATS,
+	BillStageConsideredPassedHouse:      VersionCPH,
+	BillStageConsideredPassedSenate:     VersionCPS,
+	BillStageEngrossedAmendmentHouse:    VersionEAH,
+	BillStageEngrossedAmendmentSenate:   VersionEAS,
+	BillStageEngrossedInHouse:           VersionEH,
+	BillStageEngrossedInSenate:          VersionES,
+	BillStageEnrolled:                   VersionENR,
+	BillStageHeldAtDeskHouse:            VersionHDH,
+	BillStageHeldAtDeskSenate:           VersionHDS,
+	BillStageIntroducedInHouse:          VersionIH,
+	BillStageIntroducedInSenate:         VersionIS,
+	BillStagePlacedOnCalendarHouse:      VersionPCH,
+	BillStagePlacedOnCalendarSenate:     VersionPCS,
+	BillStagePrintedAsPassed:            VersionPAP,
+	BillStagePublicPrint:                VersionPP,
+	BillStageReceivedInHouse:            VersionRDH,
+	BillStageReceivedInSenate:           VersionRDS,
+	BillStageReferenceChangeHouse:       VersionRCH,
+	BillStageReferenceChangeSenate:      VersionRCS,
+	BillStageReferralInstructionsHouse:  VersionRIH,
+	BillStageReferralInstructionsSenate: VersionRIS,
+	BillStageReferredInHouse:            VersionRFH,
+	BillStageReferredInSenate:           VersionRFS,
+	BillStageReportedInHouse:            VersionRH,
+	BillStageReportedInSenate:           VersionRS,
+}
+
+// ParseVersion returns the version with the given code, which is matched
+// case-insensitively.
+//
+// An error is returned if the code is not a known version code.
+func ParseVersion(code string) (Version, error) {
+	v := Version(strings.ToLower(strings.TrimSpace(code)))
+	if !v.Valid() {
+		return "", fmt.Errorf("unknown bill version code %q", code)
+	}
+	return v, nil
+}
+
+// VersionFromStage returns the version corresponding to the given value of
+// a document's stage attribute, or false if there is no corresponding
+// version.
+func VersionFromStage(stage BillStage) (Version, bool) {
+	v, ok := stageVersions[stage]
+	return v, ok
+}
+
+// VersionFromMetadata returns the version given in the title within the
+// given document metadata, which GPO writes in the form
+// "115 HR 1 IH: Tax Cuts and Jobs Act", or false if the metadata does not
+// include a version.
+func VersionFromMetadata(m *Metadata) (Version, bool) {
+	if m == nil || m.DublinCore == nil {
+		return "", false
+	}
+	title := m.DublinCore.Title
+	colon := strings.Index(title, ":")
+	if colon < 0 {
+		return "", false
+	}
+	fields := strings.Fields(title[:colon])
+	if len(fields) < 2 {
+		return "", false
+	}
+	v, err := ParseVersion(fields[len(fields)-1])
+	if err != nil {
+		return "", false
+	}
+	return v, true
+}
+
+var filenameRe = regexp.MustCompile(`^BILLS-\d+(?:hr|s|hres|sres|hjres|sjres|hconres|sconres)\d+([a-z]+)$`)
+
+// VersionFromFilename returns the version given in a GPO file or package
+// name such as "BILLS-115hr1enr.xml", or false if the name is not in the
+// expected form or does not include a known version code.
+func VersionFromFilename(name string) (Version, bool) {
+	base := path.Base(strings.Replace(name, "\\", "/", -1))
+	base = strings.TrimSuffix(base, path.Ext(base))
+	m := filenameRe.FindStringSubmatch(base)
+	if m == nil {
+		return "", false
+	}
+	v, err := ParseVersion(m[1])
+	if err != nil {
+		return "", false
+	}
+	return v, true
+}
+
+// Valid returns true if the receiver is a known version code.
+func (v Version) Valid() bool {
+	_, ok := versionInfos[v]
+	return ok
+}
+
+// Name returns the human-readable name of the version, such as
+// "Introduced in House", or the empty string if the version is not valid.
+func (v Version) Name() string {
+	return versionInfos[v].name
+}
+
+// Chamber returns the chamber in which the version was produced, or
+// NoChamber if the version is not specific to either chamber.
+func (v Version) Chamber() Chamber {
+	return versionInfos[v].chamber
+}
+
+// Order returns a number representing the phase of the legislative process
+// in which the version is produced, such that the versions of a bill
+// can be sorted into the order they were produced.
+//
+// Versions in the same phase return the same number, and invalid versions
+// return zero.
+func (v Version) Order() int {
+	return versionInfos[v].phase
+}
+
+func (v Version) String() string {
+	return string(v)
+}
+
+// Version returns the version of the text represented by the bill, derived
+// from the stage attribute on the root element or, failing that, from the
+// document metadata. Returns false if neither of these identifies the
+// version.
+func (b *Bill) Version() (Version, bool) {
+	if v, ok := VersionFromStage(b.Stage); ok {
+		return v, true
+	}
+	return VersionFromMetadata(b.Metadata)
+}
+
+// Version is the same as Bill.Version, but for resolutions.
+func (r *Resolution) Version() (Version, bool) {
+	if v, ok := VersionFromStage(r.Stage); ok {
+		return v, true
+	}
+	return VersionFromMetadata(r.Metadata)
+}
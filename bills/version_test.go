@@ -0,0 +1,78 @@
+package bills
+
+import (
+	"testing"
+)
+
+func TestVersion(t *testing.T) {
+	v, err := ParseVersion("ENR")
+	if err != nil {
+		t.Fatalf("error: %s", err)
+	}
+	if got, want := v, VersionENR; got != want {
+		t.Errorf("wrong version %q; want %q", got, want)
+	}
+	if got, want := v.Name(), "Enrolled Bill"; got != want {
+		t.Errorf("wrong Name %q; want %q", got, want)
+	}
+	if got, want := VersionEHR.Name(), "Engrossed in House-Reprint"; got != want {
+		t.Errorf("wrong Name for ehr %q; want %q", got, want)
+	}
+	if got, want := VersionEPH.Name(), "Engrossed and Deemed Passed by House"; got != want {
+		t.Errorf("wrong Name for eph %q; want %q", got, want)
+	}
+	if got, want := VersionRS.Chamber(), Senate; got != want {
+		t.Errorf("wrong Chamber %s; want %s", got, want)
+	}
+	if !(VersionIH.Order() < VersionRH.Order() && VersionRH.Order() < VersionEH.Order() && VersionEH.Order() < VersionENR.Order()) {
+		t.Errorf("versions are not correctly ordered")
+	}
+
+	_, err = ParseVersion("xyz")
+	if err == nil {
+		t.Errorf("no error for invalid version; want error")
+	}
+}
+
+func TestVersionFromFilename(t *testing.T) {
+	tests := []struct {
+		Input string
+		Want  Version
+		OK    bool
+	}{
+		{"BILLS-115hr1enr.xml", VersionENR, true},
+		{"/data/BILLS-115sjres5is.xml", VersionIS, true},
+		{"BILLS-115hconres71eas", VersionEAS, true},
+		{"BILLS-115hr1xyz.xml", "", false},
+		{"PLAW-115publ97.xml", "", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Input, func(t *testing.T) {
+			got, ok := VersionFromFilename(test.Input)
+			if got != test.Want || ok != test.OK {
+				t.Errorf("got %q, %t; want %q, %t", got, ok, test.Want, test.OK)
+			}
+		})
+	}
+}
+
+func TestBillVersion(t *testing.T) {
+	bill := &Bill{
+		Stage: BillStageReportedInHouse,
+	}
+	if got, ok := bill.Version(); got != VersionRH || !ok {
+		t.Errorf("got %q, %t from stage; want %q, true", got, ok, VersionRH)
+	}
+
+	bill = &Bill{
+		Metadata: &Metadata{
+			DublinCore: &DublinCore{
+				Title: "115 HR 1 PCS: Tax Cuts and Jobs Act",
+			},
+		},
+	}
+	if got, ok := bill.Version(); got != VersionPCS || !ok {
+		t.Errorf("got %q, %t from metadata; want %q, true", got, ok, VersionPCS)
+	}
+}
@@ -8,6 +8,17 @@ package bills
 // because the default implementation will not traverse any child elements
 // and thus the walk will visit nothing.
 type StructuralVisitorImpl struct {
+	cursor *Cursor
+}
+
+func (i *StructuralVisitorImpl) SetCursor(c *Cursor) {
+	i.cursor = c
+}
+
+// Cursor returns the cursor for the walk the visitor is taking part in,
+// or nil if it has not been used in a walk.
+func (i *StructuralVisitorImpl) Cursor() *Cursor {
+	return i.cursor
 }
 
 func (i *StructuralVisitorImpl) EnterCaption(Structural) {
@@ -37,6 +48,13 @@ func (i *StructuralVisitorImpl) EnterText(InlineMarkup) InlineVisitor {
 func (i *StructuralVisitorImpl) ExitText(InlineMarkup, InlineVisitor) {
 }
 
+func (i *StructuralVisitorImpl) EnterContinuationText(InlineMarkup) InlineVisitor {
+	return nil
+}
+
+func (i *StructuralVisitorImpl) ExitContinuationText(InlineMarkup, InlineVisitor) {
+}
+
 func (i *StructuralVisitorImpl) EnterQuotedBlock(*QuotedBlock) {
 }
 
@@ -102,35 +120,41 @@ func (i *InlineVisitorImpl) VisitInlineText(Text) {
 type TableVisitorImpl struct {
 }
 
-func (i *StructuralVisitorImpl) EnterTableGroup(*TableGroup) {
+func (i *TableVisitorImpl) EnterTableGroup(*TableGroup) {
+}
+
+func (i *TableVisitorImpl) ExitTableGroup(*TableGroup) {
 }
 
-func (i *StructuralVisitorImpl) ExitTableGroup(*TableGroup) {
+func (i *TableVisitorImpl) EnterTableHead(*TableRowSeq) {
 }
 
-func (i *StructuralVisitorImpl) EnterTableBody(*TableRowSeq) {
+func (i *TableVisitorImpl) ExitTableHead(*TableRowSeq) {
 }
 
-func (i *StructuralVisitorImpl) ExitTableHead(*TableRowSeq) {
+func (i *TableVisitorImpl) EnterTableBody(*TableRowSeq) {
 }
 
-func (i *StructuralVisitorImpl) EnterTableHead(*TableRowSeq) {
+func (i *TableVisitorImpl) ExitTableBody(*TableRowSeq) {
 }
 
-func (i *StructuralVisitorImpl) ExitTableBody(*TableRowSeq) {
+func (i *TableVisitorImpl) EnterTableFoot(*TableRowSeq) {
 }
 
-func (i *StructuralVisitorImpl) EnterTableRow(*TableRow) {
+func (i *TableVisitorImpl) ExitTableFoot(*TableRowSeq) {
 }
 
-func (i *StructuralVisitorImpl) ExitTableRow(*TableRow) {
+func (i *TableVisitorImpl) EnterTableRow(*TableRow) {
 }
 
-func (i *StructuralVisitorImpl) EnterTableCell(InlineMarkup) InlineVisitor {
+func (i *TableVisitorImpl) ExitTableRow(*TableRow) {
+}
+
+func (i *TableVisitorImpl) EnterTableCell(*TableEntry) InlineVisitor {
 	return nil
 }
 
-func (i *StructuralVisitorImpl) ExitTableCell(InlineMarkup) {
+func (i *TableVisitorImpl) ExitTableCell(*TableEntry, InlineVisitor) {
 }
 
 // TOCVisitorImpl provides all of the methods of TOCVisitor with no-op
@@ -159,7 +183,7 @@ func (i *TOCVisitorImpl) EnterTOCHeading(InlineMarkup) InlineVisitor {
 func (i *TOCVisitorImpl) ExitTOCHeading(InlineMarkup, InlineVisitor) {
 }
 
-func (i *TOCVisitorImpl) EnterTOCQuoted() TOCVisitor {
+func (i *TOCVisitorImpl) EnterTOCQuoted(TOCEntry) TOCVisitor {
 	return nil
 }
 
@@ -172,9 +196,202 @@ func (i *TOCVisitorImpl) ExitTOCQuoted(TOCEntry, TOCVisitor) {
 type ListVisitorImpl struct {
 }
 
-func (i *TOCVisitorImpl) EnterListItem(InlineMarkup) InlineVisitor {
+func (i *ListVisitorImpl) EnterListItem(InlineMarkup) InlineVisitor {
 	return nil
 }
 
-func (i *TOCVisitorImpl) ExitListItem(InlineMarkup, InlineVisitor) {
+func (i *ListVisitorImpl) ExitListItem(InlineMarkup, InlineVisitor) {
+}
+
+// TypedInlineVisitorImpl provides all of the methods of TypedInlineVisitor
+// with implementations that do nothing except to visit the content of
+// every element. Embedding this into another visitor struct avoids the need
+// to implement all of the methods.
+type TypedInlineVisitorImpl struct {
+}
+
+func (i *TypedInlineVisitorImpl) VisitText(Text) {
+}
+
+func (i *TypedInlineVisitorImpl) EnterActName(*ActName) bool {
+	return true
+}
+
+func (i *TypedInlineVisitorImpl) ExitActName(*ActName) {
+}
+
+func (i *TypedInlineVisitorImpl) EnterAddedPhrase(*AddedPhrase) bool {
+	return true
+}
+
+func (i *TypedInlineVisitorImpl) ExitAddedPhrase(*AddedPhrase) {
+}
+
+func (i *TypedInlineVisitorImpl) EnterBold(*Bold) bool {
+	return true
+}
+
+func (i *TypedInlineVisitorImpl) ExitBold(*Bold) {
+}
+
+func (i *TypedInlineVisitorImpl) EnterCommitteeName(*CommitteeName) bool {
+	return true
+}
+
+func (i *TypedInlineVisitorImpl) ExitCommitteeName(*CommitteeName) {
+}
+
+func (i *TypedInlineVisitorImpl) EnterCosponsor(*CosponsorName) bool {
+	return true
+}
+
+func (i *TypedInlineVisitorImpl) ExitCosponsor(*CosponsorName) {
+}
+
+func (i *TypedInlineVisitorImpl) EnterDefinition(*Definition) bool {
+	return true
+}
+
+func (i *TypedInlineVisitorImpl) ExitDefinition(*Definition) {
+}
+
+func (i *TypedInlineVisitorImpl) EnterDeletedPhrase(*DeletedPhrase) bool {
+	return true
+}
+
+func (i *TypedInlineVisitorImpl) ExitDeletedPhrase(*DeletedPhrase) {
 }
+
+func (i *TypedInlineVisitorImpl) EnterEditorial(*Editorial) bool {
+	return true
+}
+
+func (i *TypedInlineVisitorImpl) ExitEditorial(*Editorial) {
+}
+
+func (i *TypedInlineVisitorImpl) EnterEffectiveDate(*EffectiveDate) bool {
+	return true
+}
+
+func (i *TypedInlineVisitorImpl) ExitEffectiveDate(*EffectiveDate) {
+}
+
+func (i *TypedInlineVisitorImpl) EnterExternalXref(*ExternalCrossReference) bool {
+	return true
+}
+
+func (i *TypedInlineVisitorImpl) ExitExternalXref(*ExternalCrossReference) {
+}
+
+func (i *TypedInlineVisitorImpl) EnterFootnote(*Footnote) bool {
+	return true
+}
+
+func (i *TypedInlineVisitorImpl) ExitFootnote(*Footnote) {
+}
+
+func (i *TypedInlineVisitorImpl) EnterFraction(*Fraction) bool {
+	return true
+}
+
+func (i *TypedInlineVisitorImpl) ExitFraction(*Fraction) {
+}
+
+func (i *TypedInlineVisitorImpl) EnterInternalXref(*InternalCrossReference) bool {
+	return true
+}
+
+func (i *TypedInlineVisitorImpl) ExitInternalXref(*InternalCrossReference) {
+}
+
+func (i *TypedInlineVisitorImpl) EnterItalic(*Italic) bool {
+	return true
+}
+
+func (i *TypedInlineVisitorImpl) ExitItalic(*Italic) {
+}
+
+func (i *TypedInlineVisitorImpl) EnterNonsponsor(*NonsponsorName) bool {
+	return true
+}
+
+func (i *TypedInlineVisitorImpl) ExitNonsponsor(*NonsponsorName) {
+}
+
+func (i *TypedInlineVisitorImpl) EnterQuote(*InlineQuote) bool {
+	return true
+}
+
+func (i *TypedInlineVisitorImpl) ExitQuote(*InlineQuote) {
+}
+
+func (i *TypedInlineVisitorImpl) EnterShortTitle(*ShortTitle) bool {
+	return true
+}
+
+func (i *TypedInlineVisitorImpl) ExitShortTitle(*ShortTitle) {
+}
+
+func (i *TypedInlineVisitorImpl) EnterSponsor(*SponsorName) bool {
+	return true
+}
+
+func (i *TypedInlineVisitorImpl) ExitSponsor(*SponsorName) {
+}
+
+func (i *TypedInlineVisitorImpl) EnterSubscript(*Subscript) bool {
+	return true
+}
+
+func (i *TypedInlineVisitorImpl) ExitSubscript(*Subscript) {
+}
+
+func (i *TypedInlineVisitorImpl) EnterSuperscript(*Superscript) bool {
+	return true
+}
+
+func (i *TypedInlineVisitorImpl) ExitSuperscript(*Superscript) {
+}
+
+func (i *TypedInlineVisitorImpl) EnterTerm(*Term) bool {
+	return true
+}
+
+func (i *TypedInlineVisitorImpl) ExitTerm(*Term) {
+}
+
+func (i *TypedInlineVisitorImpl) VisitFootnoteRef(*FootnoteRef) {
+}
+
+func (i *TypedInlineVisitorImpl) VisitLineBreak(*LineBreak) {
+}
+
+func (i *TypedInlineVisitorImpl) VisitNoBreak(*NoBreak) {
+}
+
+func (i *TypedInlineVisitorImpl) VisitOmittedText(*OmittedText) {
+}
+
+func (i *TypedInlineVisitorImpl) VisitPageBreak(*PageBreak) {
+}
+
+func (i *TypedInlineVisitorImpl) EnterOtherInline(Inline) bool {
+	return true
+}
+
+func (i *TypedInlineVisitorImpl) ExitOtherInline(Inline) {
+}
+
+func (i *TypedInlineVisitorImpl) VisitOtherInline(Inline) {
+}
+
+var (
+	_ StructuralVisitor = (*StructuralVisitorImpl)(nil)
+	_ CursorVisitor     = (*StructuralVisitorImpl)(nil)
+	_ InlineVisitor     = (*InlineVisitorImpl)(nil)
+	_ TableVisitor      = (*TableVisitorImpl)(nil)
+	_ TOCVisitor        = (*TOCVisitorImpl)(nil)
+	_ ListVisitor       = (*ListVisitorImpl)(nil)
+
+	_ TypedInlineVisitor = (*TypedInlineVisitorImpl)(nil)
+)
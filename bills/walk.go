@@ -19,9 +19,14 @@ type StructuralVisitor interface {
 	EnterHeader(InlineMarkup) InlineVisitor
 	ExitHeader(InlineMarkup, InlineVisitor)
 
+	// EnterText and ExitText are used both for the text of a structural
+	// element and for paragraphs of text directly within a quoted block.
 	EnterText(InlineMarkup) InlineVisitor
 	ExitText(InlineMarkup, InlineVisitor)
 
+	EnterContinuationText(InlineMarkup) InlineVisitor
+	ExitContinuationText(InlineMarkup, InlineVisitor)
+
 	EnterQuotedBlock(*QuotedBlock)
 	ExitQuotedBlock(*QuotedBlock)
 
@@ -67,17 +72,26 @@ type TableVisitor interface {
 	EnterTableBody(*TableRowSeq)
 	ExitTableBody(*TableRowSeq)
 
+	EnterTableFoot(*TableRowSeq)
+	ExitTableFoot(*TableRowSeq)
+
 	EnterTableRow(*TableRow)
 	ExitTableRow(*TableRow)
 
-	EnterTableCell(InlineMarkup)
-	ExitTableCell(InlineMarkup)
+	EnterTableCell(*TableEntry) InlineVisitor
+	ExitTableCell(*TableEntry, InlineVisitor)
 }
 
 type TOCVisitor interface {
+	// EnterTOCEntry and ExitTOCEntry delimit each unquoted entry. Quoted
+	// entries are instead delimited by EnterTOCQuoted and ExitTOCQuoted,
+	// and the entry they contain is then visited using the TOCVisitor
+	// returned from EnterTOCQuoted.
 	EnterTOCEntry(TOCEntry)
 	ExitTOCEntry(TOCEntry)
 
+	// EnterTOCEnum and ExitTOCEnum are not currently called, because the
+	// supported entry types carry their enumerators within their headings.
 	EnterTOCEnum(InlineMarkup) InlineVisitor
 	ExitTOCEnum(InlineMarkup, InlineVisitor)
 
@@ -90,12 +104,24 @@ type TOCVisitor interface {
 
 type ListVisitor interface {
 	EnterListItem(InlineMarkup) InlineVisitor
-	ExitListItem(InlineMarkup) InlineVisitor
+	ExitListItem(InlineMarkup, InlineVisitor)
+}
+
+// CursorVisitor is an optional interface that a StructuralVisitor can
+// implement to be given a Cursor describing the current position of the
+// walk. StructuralVisitorImpl implements it, so visitors that embed it can
+// call its Cursor method from within their other methods.
+type CursorVisitor interface {
+	// SetCursor is called before the visitor is first used in a walk. The
+	// given cursor is updated in-place as the walk progresses.
+	SetCursor(*Cursor)
 }
 
 func (m StructuralMarkup) Walk(v StructuralVisitor) {
-	for _, node := range m {
-		structuralWalk(v, node)
+	w := &walker{cursor: &Cursor{}}
+	w.setCursor(v)
+	for i, node := range m {
+		w.structural(v, node, i)
 	}
 }
 
@@ -110,22 +136,37 @@ func (m InlineMarkup) Walk(v InlineVisitor) {
 		if cn == nil {
 			v.VisitInlineElement(n)
 		} else {
-			cv := v.EnterInlineElement(cn)
+			cv := v.EnterInlineElement(n)
 			if cv != nil {
-				cn.Walk(v)
-				v.ExitInlineElement(cn, cv)
+				cn.Walk(cv)
+				v.ExitInlineElement(n, cv)
 			}
 		}
 	}
 }
 
-func structuralWalk(v StructuralVisitor, n Structural) {
+// walker tracks the state of a walk over structural markup.
+type walker struct {
+	cursor *Cursor
+}
+
+func (w *walker) setCursor(v StructuralVisitor) {
+	if cv, ok := v.(CursorVisitor); ok {
+		cv.SetCursor(w.cursor)
+	}
+}
+
+func (w *walker) structural(v StructuralVisitor, n Structural, index int) {
 	childNodes := n.ChildElements()
 
+	w.cursor.push(n, index)
+	defer w.cursor.pop()
+
 	cv := v.EnterStructuralElement(n)
 	if cv == nil {
 		return
 	}
+	w.setCursor(cv)
 
 	enum := n.Enumerator()
 	header := n.Header()
@@ -149,7 +190,167 @@ func structuralWalk(v StructuralVisitor, n Structural) {
 		v.ExitCaption(n)
 	}
 
-	childNodes.Walk(cv)
+	if text := n.Text(); text != nil {
+		textWalk(v, text)
+	}
+
+	for _, block := range n.Blocks() {
+		w.block(v, block)
+	}
+
+	for i, child := range childNodes {
+		w.structural(cv, child, i)
+	}
+
+	if text := n.ContinuationText(); text != nil {
+		iv := v.EnterContinuationText(text)
+		if iv != nil {
+			text.Walk(iv)
+			v.ExitContinuationText(text, iv)
+		}
+	}
 
 	v.ExitStructuralElement(n, cv)
 }
+
+func textWalk(v StructuralVisitor, text InlineMarkup) {
+	iv := v.EnterText(text)
+	if iv != nil {
+		text.Walk(iv)
+		v.ExitText(text, iv)
+	}
+}
+
+func (w *walker) block(v StructuralVisitor, n Block) {
+	switch n := n.(type) {
+	case *QuotedBlock:
+		v.EnterQuotedBlock(n)
+		w.cursor.quoted = append(w.cursor.quoted, n)
+		// Only the structural elements are counted as siblings for the
+		// purpose of Cursor.Index.
+		index := 0
+		for _, c := range n.Content {
+			switch c := c.(type) {
+			case Structural:
+				w.structural(v, c, index)
+				index++
+			case Block:
+				w.block(v, c)
+			case InlineMarkup:
+				textWalk(v, c)
+			}
+		}
+		w.cursor.quoted = w.cursor.quoted[:len(w.cursor.quoted)-1]
+		v.ExitQuotedBlock(n)
+	case *Graphic:
+		v.VisitGraphic(n)
+	case *Formula:
+		v.VisitFormula(n)
+	case *TableOfContents:
+		tv := v.EnterTOC(n)
+		if tv != nil {
+			tocWalk(tv, n.Entries)
+			v.ExitTOC(n, tv)
+		}
+	case *Table:
+		tv := v.EnterTable(n)
+		if tv != nil {
+			tableWalk(tv, n)
+			v.ExitTable(n, tv)
+		}
+	case *List:
+		lv := v.EnterList(n)
+		if lv != nil {
+			for _, item := range n.Items {
+				iv := lv.EnterListItem(item)
+				if iv != nil {
+					item.Walk(iv)
+					lv.ExitListItem(item, iv)
+				}
+			}
+			v.ExitList(n, lv)
+		}
+	}
+}
+
+func tocWalk(v TOCVisitor, entries TOCList) {
+	for _, entry := range entries {
+		tocEntryWalk(v, entry)
+	}
+}
+
+func tocEntryWalk(v TOCVisitor, entry TOCEntry) {
+	var header InlineMarkup
+
+	switch e := entry.(type) {
+	case *SimpleTOCEntry:
+		header = e.Header
+	case *MultiColumnTOCEntry:
+		header = e.Header
+	case *QuotedSimpleTOCEntry:
+		qv := v.EnterTOCQuoted(e)
+		if qv != nil {
+			if e.Entry != nil {
+				tocEntryWalk(qv, e.Entry)
+			}
+			v.ExitTOCQuoted(e, qv)
+		}
+		return
+	case *QuotedMultiColumnTOCEntry:
+		qv := v.EnterTOCQuoted(e)
+		if qv != nil {
+			if e.Entry != nil {
+				tocEntryWalk(qv, e.Entry)
+			}
+			v.ExitTOCQuoted(e, qv)
+		}
+		return
+	}
+
+	v.EnterTOCEntry(entry)
+	if header != nil {
+		iv := v.EnterTOCHeading(header)
+		if iv != nil {
+			header.Walk(iv)
+			v.ExitTOCHeading(header, iv)
+		}
+	}
+	v.ExitTOCEntry(entry)
+}
+
+func tableWalk(v TableVisitor, n *Table) {
+	for _, group := range n.Groups {
+		v.EnterTableGroup(group)
+		if group.Head != nil {
+			v.EnterTableHead(group.Head)
+			tableRowsWalk(v, group.Head)
+			v.ExitTableHead(group.Head)
+		}
+		for _, body := range group.Bodies {
+			v.EnterTableBody(body)
+			tableRowsWalk(v, body)
+			v.ExitTableBody(body)
+		}
+		if group.Foot != nil {
+			v.EnterTableFoot(group.Foot)
+			tableRowsWalk(v, group.Foot)
+			v.ExitTableFoot(group.Foot)
+		}
+		v.ExitTableGroup(group)
+	}
+}
+
+func tableRowsWalk(v TableVisitor, seq *TableRowSeq) {
+	for i := range seq.Rows {
+		row := &seq.Rows[i]
+		v.EnterTableRow(row)
+		for _, entry := range row.Entries {
+			iv := v.EnterTableCell(entry)
+			if iv != nil {
+				entry.InlineMarkup.Walk(iv)
+				v.ExitTableCell(entry, iv)
+			}
+		}
+		v.ExitTableRow(row)
+	}
+}
@@ -0,0 +1,342 @@
+package bills
+
+import (
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/davecgh/go-spew/spew"
+)
+
+func TestStructuralMarkupWalk(t *testing.T) {
+	input := `<legis-body>
+<section id="H1"><enum>1.</enum><header>Amendment</header><text>Section 2 is amended to read:</text>
+<quoted-block id="H2" style="OLC">
+<section id="H3"><enum>2.</enum><text>New text.</text></section>
+<text>Loose paragraph.</text>
+</quoted-block>
+<graphic file="chart.eps"/>
+<toc>
+<toc-entry level="section">Sec. 1. Amendment.</toc-entry>
+<toc-quoted-entry style="OLC"><toc-entry level="section">Sec. 2. New.</toc-entry></toc-quoted-entry>
+</toc>
+<table>
+<tgroup cols="1">
+<thead><row><entry>Head</entry></row></thead>
+<tbody><row><entry>Body</entry></row></tbody>
+<tfoot><row><entry>Foot</entry></row></tfoot>
+</tgroup>
+</table>
+<list><list-item>First</list-item></list>
+<subsection id="H4"><enum>(a)</enum><text>Child.</text></subsection>
+<continuation-text>Continued.</continuation-text>
+</section>
+</legis-body>`
+
+	var m StructuralMarkup
+	err := xml.Unmarshal([]byte(input), &m)
+	if err != nil {
+		t.Fatalf("error: %s", err)
+	}
+
+	v := &recordingVisitor{}
+	m.Walk(v)
+
+	want := []string{
+		"EnterStructuralElement *bills.Section",
+		"EnterCaption",
+		"EnterEnum",
+		"VisitInlineText 1.",
+		"ExitEnum",
+		"EnterHeader",
+		"VisitInlineText Amendment",
+		"ExitHeader",
+		"ExitCaption",
+		"EnterText",
+		"VisitInlineText Section 2 is amended to read:",
+		"ExitText",
+		"EnterQuotedBlock H2",
+		"EnterStructuralElement *bills.Section",
+		"EnterCaption",
+		"EnterEnum",
+		"VisitInlineText 2.",
+		"ExitEnum",
+		"ExitCaption",
+		"EnterText",
+		"VisitInlineText New text.",
+		"ExitText",
+		"ExitStructuralElement *bills.Section",
+		"EnterText",
+		"VisitInlineText Loose paragraph.",
+		"ExitText",
+		"ExitQuotedBlock H2",
+		"VisitGraphic chart.eps",
+		"EnterTOC",
+		"EnterTOCEntry *bills.SimpleTOCEntry",
+		"EnterTOCHeading",
+		"VisitInlineText Sec. 1. Amendment.",
+		"ExitTOCHeading",
+		"ExitTOCEntry *bills.SimpleTOCEntry",
+		"EnterTOCQuoted *bills.QuotedSimpleTOCEntry",
+		"EnterTOCEntry *bills.SimpleTOCEntry",
+		"EnterTOCHeading",
+		"VisitInlineText Sec. 2. New.",
+		"ExitTOCHeading",
+		"ExitTOCEntry *bills.SimpleTOCEntry",
+		"ExitTOCQuoted *bills.QuotedSimpleTOCEntry",
+		"ExitTOC",
+		"EnterTable",
+		"EnterTableGroup",
+		"EnterTableHead",
+		"EnterTableRow",
+		"EnterTableCell",
+		"VisitInlineText Head",
+		"ExitTableCell",
+		"ExitTableRow",
+		"ExitTableHead",
+		"EnterTableBody",
+		"EnterTableRow",
+		"EnterTableCell",
+		"VisitInlineText Body",
+		"ExitTableCell",
+		"ExitTableRow",
+		"ExitTableBody",
+		"EnterTableFoot",
+		"EnterTableRow",
+		"EnterTableCell",
+		"VisitInlineText Foot",
+		"ExitTableCell",
+		"ExitTableRow",
+		"ExitTableFoot",
+		"ExitTableGroup",
+		"ExitTable",
+		"EnterList",
+		"EnterListItem",
+		"VisitInlineText First",
+		"ExitListItem",
+		"ExitList",
+		"EnterStructuralElement *bills.Subsection",
+		"EnterCaption",
+		"EnterEnum",
+		"VisitInlineText (a)",
+		"ExitEnum",
+		"ExitCaption",
+		"EnterText",
+		"VisitInlineText Child.",
+		"ExitText",
+		"ExitStructuralElement *bills.Subsection",
+		"EnterContinuationText",
+		"VisitInlineText Continued.",
+		"ExitContinuationText",
+		"ExitStructuralElement *bills.Section",
+	}
+
+	// Whitespace-only text nodes between elements are not interesting here.
+	var got []string
+	for _, entry := range v.log {
+		if text, ok := strings.CutPrefix(entry, "VisitInlineText "); ok && strings.TrimSpace(text) == "" {
+			continue
+		}
+		got = append(got, entry)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf(
+			"wrong callback sequence\ngot:  %s\nwant: %s",
+			spew.Sdump(got),
+			spew.Sdump(want),
+		)
+	}
+}
+
+func TestStructuralMarkupWalkSkip(t *testing.T) {
+	input := `<legis-body>
+<section><enum>1.</enum><text>Text.</text><subsection><text>Child.</text></subsection></section>
+</legis-body>`
+
+	var m StructuralMarkup
+	err := xml.Unmarshal([]byte(input), &m)
+	if err != nil {
+		t.Fatalf("error: %s", err)
+	}
+
+	// The default implementations return nil from all of the Enter methods,
+	// so nothing beneath the section should be visited.
+	v := &skippingVisitor{}
+	m.Walk(v)
+
+	if got, want := v.entered, 1; got != want {
+		t.Errorf("entered %d elements; want %d", got, want)
+	}
+}
+
+type skippingVisitor struct {
+	StructuralVisitorImpl
+	entered int
+}
+
+func (v *skippingVisitor) EnterStructuralElement(Structural) StructuralVisitor {
+	v.entered++
+	return nil
+}
+
+// recordingVisitor implements all of the visitor interfaces, recording each
+// call in its log and always continuing the walk.
+type recordingVisitor struct {
+	log []string
+}
+
+func (v *recordingVisitor) record(format string, args ...interface{}) {
+	v.log = append(v.log, fmt.Sprintf(format, args...))
+}
+
+func (v *recordingVisitor) EnterCaption(Structural) { v.record("EnterCaption") }
+func (v *recordingVisitor) ExitCaption(Structural)  { v.record("ExitCaption") }
+
+func (v *recordingVisitor) EnterEnum(InlineMarkup) InlineVisitor {
+	v.record("EnterEnum")
+	return v
+}
+func (v *recordingVisitor) ExitEnum(InlineMarkup, InlineVisitor) { v.record("ExitEnum") }
+
+func (v *recordingVisitor) EnterHeader(InlineMarkup) InlineVisitor {
+	v.record("EnterHeader")
+	return v
+}
+func (v *recordingVisitor) ExitHeader(InlineMarkup, InlineVisitor) { v.record("ExitHeader") }
+
+func (v *recordingVisitor) EnterText(InlineMarkup) InlineVisitor {
+	v.record("EnterText")
+	return v
+}
+func (v *recordingVisitor) ExitText(InlineMarkup, InlineVisitor) { v.record("ExitText") }
+
+func (v *recordingVisitor) EnterContinuationText(InlineMarkup) InlineVisitor {
+	v.record("EnterContinuationText")
+	return v
+}
+func (v *recordingVisitor) ExitContinuationText(InlineMarkup, InlineVisitor) {
+	v.record("ExitContinuationText")
+}
+
+func (v *recordingVisitor) EnterQuotedBlock(n *QuotedBlock) { v.record("EnterQuotedBlock %s", n.Id) }
+func (v *recordingVisitor) ExitQuotedBlock(n *QuotedBlock)  { v.record("ExitQuotedBlock %s", n.Id) }
+
+func (v *recordingVisitor) VisitGraphic(n *Graphic) { v.record("VisitGraphic %s", n.File) }
+func (v *recordingVisitor) VisitFormula(*Formula)   { v.record("VisitFormula") }
+
+func (v *recordingVisitor) EnterTOC(*TableOfContents) TOCVisitor {
+	v.record("EnterTOC")
+	return v
+}
+func (v *recordingVisitor) ExitTOC(*TableOfContents, TOCVisitor) { v.record("ExitTOC") }
+
+func (v *recordingVisitor) EnterTable(*Table) TableVisitor {
+	v.record("EnterTable")
+	return v
+}
+func (v *recordingVisitor) ExitTable(*Table, TableVisitor) { v.record("ExitTable") }
+
+func (v *recordingVisitor) EnterList(*List) ListVisitor {
+	v.record("EnterList")
+	return v
+}
+func (v *recordingVisitor) ExitList(*List, ListVisitor) { v.record("ExitList") }
+
+func (v *recordingVisitor) EnterStructuralElement(n Structural) StructuralVisitor {
+	v.record("EnterStructuralElement %T", n)
+	return v
+}
+func (v *recordingVisitor) ExitStructuralElement(n Structural, _ StructuralVisitor) {
+	v.record("ExitStructuralElement %T", n)
+}
+
+func (v *recordingVisitor) EnterInlineElement(n Inline) InlineVisitor {
+	v.record("EnterInlineElement %T", n)
+	return v
+}
+func (v *recordingVisitor) ExitInlineElement(n Inline, _ InlineVisitor) {
+	v.record("ExitInlineElement %T", n)
+}
+func (v *recordingVisitor) VisitInlineElement(n Inline) { v.record("VisitInlineElement %T", n) }
+func (v *recordingVisitor) VisitInlineText(n Text)      { v.record("VisitInlineText %s", n) }
+
+func (v *recordingVisitor) EnterTableGroup(*TableGroup) { v.record("EnterTableGroup") }
+func (v *recordingVisitor) ExitTableGroup(*TableGroup)  { v.record("ExitTableGroup") }
+func (v *recordingVisitor) EnterTableHead(*TableRowSeq) { v.record("EnterTableHead") }
+func (v *recordingVisitor) ExitTableHead(*TableRowSeq)  { v.record("ExitTableHead") }
+func (v *recordingVisitor) EnterTableBody(*TableRowSeq) { v.record("EnterTableBody") }
+func (v *recordingVisitor) ExitTableBody(*TableRowSeq)  { v.record("ExitTableBody") }
+func (v *recordingVisitor) EnterTableFoot(*TableRowSeq) { v.record("EnterTableFoot") }
+func (v *recordingVisitor) ExitTableFoot(*TableRowSeq)  { v.record("ExitTableFoot") }
+func (v *recordingVisitor) EnterTableRow(*TableRow)     { v.record("EnterTableRow") }
+func (v *recordingVisitor) ExitTableRow(*TableRow)      { v.record("ExitTableRow") }
+
+func (v *recordingVisitor) EnterTableCell(*TableEntry) InlineVisitor {
+	v.record("EnterTableCell")
+	return v
+}
+func (v *recordingVisitor) ExitTableCell(*TableEntry, InlineVisitor) { v.record("ExitTableCell") }
+
+func (v *recordingVisitor) EnterTOCEntry(n TOCEntry) { v.record("EnterTOCEntry %T", n) }
+func (v *recordingVisitor) ExitTOCEntry(n TOCEntry)  { v.record("ExitTOCEntry %T", n) }
+
+func (v *recordingVisitor) EnterTOCEnum(InlineMarkup) InlineVisitor {
+	v.record("EnterTOCEnum")
+	return v
+}
+func (v *recordingVisitor) ExitTOCEnum(InlineMarkup, InlineVisitor) { v.record("ExitTOCEnum") }
+
+func (v *recordingVisitor) EnterTOCHeading(InlineMarkup) InlineVisitor {
+	v.record("EnterTOCHeading")
+	return v
+}
+func (v *recordingVisitor) ExitTOCHeading(InlineMarkup, InlineVisitor) { v.record("ExitTOCHeading") }
+
+func (v *recordingVisitor) EnterTOCQuoted(n TOCEntry) TOCVisitor {
+	v.record("EnterTOCQuoted %T", n)
+	return v
+}
+func (v *recordingVisitor) ExitTOCQuoted(n TOCEntry, _ TOCVisitor) {
+	v.record("ExitTOCQuoted %T", n)
+}
+
+func (v *recordingVisitor) EnterListItem(InlineMarkup) InlineVisitor {
+	v.record("EnterListItem")
+	return v
+}
+func (v *recordingVisitor) ExitListItem(InlineMarkup, InlineVisitor) { v.record("ExitListItem") }
+
+func TestInlineMarkupWalk(t *testing.T) {
+	input := `<text>See <external-xref legal-doc="usc" parsable-cite="usc/26/1"><italic>section</italic> 1</external-xref><footnote-ref idref="F1"/>.</text>`
+
+	var m InlineMarkup
+	err := xml.Unmarshal([]byte(input), &m)
+	if err != nil {
+		t.Fatalf("error: %s", err)
+	}
+
+	v := &recordingVisitor{}
+	m.Walk(v)
+
+	want := []string{
+		"VisitInlineText See ",
+		"EnterInlineElement *bills.ExternalCrossReference",
+		"EnterInlineElement *bills.Italic",
+		"VisitInlineText section",
+		"ExitInlineElement *bills.Italic",
+		"VisitInlineText  1",
+		"ExitInlineElement *bills.ExternalCrossReference",
+		"VisitInlineElement *bills.FootnoteRef",
+		"VisitInlineText .",
+	}
+	if !reflect.DeepEqual(v.log, want) {
+		t.Errorf(
+			"wrong callback sequence\ngot:  %s\nwant: %s",
+			spew.Sdump(v.log),
+			spew.Sdump(want),
+		)
+	}
+}
@@ -1,9 +1,11 @@
 package bills
 
 import (
+	"encoding"
 	"encoding/xml"
 	"fmt"
 	"reflect"
+	"strconv"
 	"strings"
 )
 
@@ -17,7 +19,16 @@ import (
 // TextWrapper but add their own attributes that need to be decoded
 // before delegating to TextWrapper's decoding function.
 //
-// Only non-namespaced attributes are supported.
+// As with xml.Unmarshal, fields may be of any type that implements
+// xml.UnmarshalerAttr or encoding.TextUnmarshaler, of any string, bool,
+// integer or floating point kind, or pointers to any of these. Fields of
+// embedded structs are decoded as if they were fields of the outer struct.
+// Fields whose attributes are not present are left unchanged.
+//
+// Attributes in a namespace can be selected with a tag that gives the
+// namespace before the attribute name, separated by a space, as in
+// `xml:"http://purl.org/dc/elements/1.1/ date,attr"`. A tag without a
+// namespace matches only an attribute without a namespace.
 func decodeXMLAttrs(target interface{}, start xml.StartElement) error {
 	val := reflect.ValueOf(target)
 
@@ -43,14 +54,15 @@ func decodeXMLAttrs(target interface{}, start xml.StartElement) error {
 		return fmt.Errorf("decodeXMLAttrs requires struct target")
 	}
 
-	attrs := map[string]string{}
+	attrs := make(map[xml.Name]xml.Attr, len(start.Attr))
 	for _, attr := range start.Attr {
-		if attr.Name.Space != "" {
-			continue
-		}
-		attrs[attr.Name.Local] = attr.Value
+		attrs[attr.Name] = attr
 	}
 
+	return decodeXMLAttrsStruct(val, attrs)
+}
+
+func decodeXMLAttrsStruct(val reflect.Value, attrs map[xml.Name]xml.Attr) error {
 	typ := val.Type()
 	n := typ.NumField()
 	for i := 0; i < n; i++ {
@@ -60,8 +72,15 @@ func decodeXMLAttrs(target interface{}, start xml.StartElement) error {
 			continue // Private field
 		}
 
-		if i := strings.Index(tag, " "); i >= 0 {
-			// namespaced attributes not supported
+		if f.Anonymous && tag == "" {
+			// Embedded structs contribute their fields as if they were
+			// fields of the outer struct.
+			if f.Type.Kind() == reflect.Struct {
+				err := decodeXMLAttrsStruct(val.Field(i), attrs)
+				if err != nil {
+					return err
+				}
+			}
 			continue
 		}
 
@@ -82,13 +101,100 @@ func decodeXMLAttrs(target interface{}, start xml.StartElement) error {
 			continue
 		}
 
-		attrName := tokens[0]
-		if attrName == "" {
+		var attrName xml.Name
+		if i := strings.Index(tokens[0], " "); i >= 0 {
+			attrName.Space = tokens[0][:i]
+			attrName.Local = tokens[0][i+1:]
+		} else {
+			attrName.Local = tokens[0]
+		}
+		if attrName.Local == "" {
 			continue
 		}
 
-		val.Field(i).Set(reflect.ValueOf(attrs[attrName]))
+		attr, ok := attrs[attrName]
+		if !ok {
+			continue
+		}
+
+		err := decodeXMLAttrValue(val.Field(i), attr)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// decodeXMLAttrValue decodes the value of the given attribute into the
+// given field, which must be addressable.
+func decodeXMLAttrValue(field reflect.Value, attr xml.Attr) error {
+	if field.Kind() == reflect.Ptr {
+		// We decode into a new value and then only assign it if decoding
+		// succeeds, so that the field is left unchanged on error.
+		nv := reflect.New(field.Type().Elem())
+		err := decodeXMLAttrValue(nv.Elem(), attr)
+		if err != nil {
+			return err
+		}
+		field.Set(nv)
+		return nil
+	}
+
+	addr := field.Addr().Interface()
+	if u, ok := addr.(xml.UnmarshalerAttr); ok {
+		return u.UnmarshalXMLAttr(attr)
+	}
+	if u, ok := addr.(encoding.TextUnmarshaler); ok {
+		err := u.UnmarshalText([]byte(attr.Value))
+		if err != nil {
+			return fmt.Errorf("invalid %s attribute value %q: %s", attr.Name.Local, attr.Value, err)
+		}
+		return nil
+	}
+
+	value := attr.Value
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(value)
+		return nil
 	}
 
+	// As with xml.Unmarshal, we treat an empty value as the zero value for
+	// all of the non-string kinds.
+	value = strings.TrimSpace(value)
+	if value == "" {
+		field.Set(reflect.Zero(field.Type()))
+		return nil
+	}
+
+	switch field.Kind() {
+	case reflect.Bool:
+		v, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("invalid %s attribute value %q: must be a boolean", attr.Name.Local, attr.Value)
+		}
+		field.SetBool(v)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v, err := strconv.ParseInt(value, 10, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("invalid %s attribute value %q: must be an integer", attr.Name.Local, attr.Value)
+		}
+		field.SetInt(v)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		v, err := strconv.ParseUint(value, 10, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("invalid %s attribute value %q: must be a non-negative integer", attr.Name.Local, attr.Value)
+		}
+		field.SetUint(v)
+	case reflect.Float32, reflect.Float64:
+		v, err := strconv.ParseFloat(value, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("invalid %s attribute value %q: must be a number", attr.Name.Local, attr.Value)
+		}
+		field.SetFloat(v)
+	default:
+		return fmt.Errorf("can't decode %s attribute into %s", attr.Name.Local, field.Type())
+	}
 	return nil
 }
@@ -0,0 +1,102 @@
+package bills
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+)
+
+type xmlAttrsTestTarget struct {
+	xmlAttrsTestEmbedded
+
+	String   string    `xml:"string,attr"`
+	Stage    BillStage `xml:"stage,attr"`
+	Bool     bool      `xml:"bool,attr"`
+	Int      int       `xml:"int,attr"`
+	Uint     uint8     `xml:"uint,attr"`
+	Float    float64   `xml:"float,attr"`
+	Date     *Date     `xml:"date,attr"`
+	NSDate   Date      `xml:"http://example.com/ns date,attr"`
+	Absent   string    `xml:"absent,attr"`
+	Content  string    `xml:"content"`
+	Unmarked string
+}
+
+type xmlAttrsTestEmbedded struct {
+	Embedded string `xml:"embedded,attr"`
+}
+
+func TestDecodeXMLAttrs(t *testing.T) {
+	start := xml.StartElement{
+		Name: xml.Name{Local: "test"},
+		Attr: []xml.Attr{
+			{Name: xml.Name{Local: "string"}, Value: "hello"},
+			{Name: xml.Name{Local: "stage"}, Value: "Enrolled-Bill"},
+			{Name: xml.Name{Local: "bool"}, Value: "true"},
+			{Name: xml.Name{Local: "int"}, Value: "-12"},
+			{Name: xml.Name{Local: "uint"}, Value: "200"},
+			{Name: xml.Name{Local: "float"}, Value: "1.5"},
+			{Name: xml.Name{Local: "date"}, Value: "20170103"},
+			{Name: xml.Name{Space: "http://example.com/ns", Local: "date"}, Value: "20180204"},
+			{Name: xml.Name{Local: "embedded"}, Value: "inner"},
+			{Name: xml.Name{Local: "content"}, Value: "not an attribute field"},
+		},
+	}
+
+	target := &xmlAttrsTestTarget{
+		Absent: "unchanged",
+	}
+	err := decodeXMLAttrs(target, start)
+	if err != nil {
+		t.Fatalf("error: %s", err)
+	}
+
+	want := xmlAttrsTestTarget{
+		xmlAttrsTestEmbedded: xmlAttrsTestEmbedded{
+			Embedded: "inner",
+		},
+		String: "hello",
+		Stage:  BillStageEnrolled,
+		Bool:   true,
+		Int:    -12,
+		Uint:   200,
+		Float:  1.5,
+		Date:   &Date{2017, time.January, 3},
+		NSDate: Date{2018, time.February, 4},
+		Absent: "unchanged",
+	}
+	if target.Date == nil || *target.Date != *want.Date {
+		t.Errorf("wrong Date %v; want %v", target.Date, want.Date)
+	}
+	target.Date = want.Date
+	if *target != want {
+		t.Errorf("wrong result\ngot:  %#v\nwant: %#v", *target, want)
+	}
+}
+
+func TestDecodeXMLAttrsErrors(t *testing.T) {
+	tests := []xml.Attr{
+		{Name: xml.Name{Local: "bool"}, Value: "maybe"},
+		{Name: xml.Name{Local: "int"}, Value: "twelve"},
+		{Name: xml.Name{Local: "uint"}, Value: "300"},
+		{Name: xml.Name{Local: "float"}, Value: "x"},
+		{Name: xml.Name{Local: "date"}, Value: "20171301"},
+	}
+
+	for _, attr := range tests {
+		t.Run(attr.Name.Local, func(t *testing.T) {
+			start := xml.StartElement{
+				Name: xml.Name{Local: "test"},
+				Attr: []xml.Attr{attr},
+			}
+			target := &xmlAttrsTestTarget{}
+			err := decodeXMLAttrs(target, start)
+			if err == nil {
+				t.Fatalf("no error; want error")
+			}
+			if target.Date != nil {
+				t.Errorf("Date was set despite error")
+			}
+		})
+	}
+}
@@ -0,0 +1,217 @@
+package billstatus
+
+import (
+	"encoding/xml"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// BillStatus represents a complete Bill Status document.
+type BillStatus struct {
+	// Version is the version of the Bill Status schema that the document
+	// conforms to, such as "3.0.0".
+	Version string `xml:"version"`
+
+	Bill *Bill `xml:"bill"`
+}
+
+func ParseBillStatus(r io.Reader) (*BillStatus, error) {
+	decoder := xml.NewDecoder(r)
+	var status BillStatus
+	err := decoder.Decode(&status)
+	return &status, err
+}
+
+func ParseBillStatusBuffer(buf []byte) (*BillStatus, error) {
+	var status BillStatus
+	err := xml.Unmarshal(buf, &status)
+	return &status, err
+}
+
+// Bill represents the status information for a single bill or resolution.
+type Bill struct {
+	Congress       int        `xml:"congress"`
+	Type           string     `xml:"type"`
+	Number         int        `xml:"number"`
+	OriginChamber  string     `xml:"originChamber"`
+	IntroducedDate *Date      `xml:"introducedDate"`
+	UpdateDate     *Timestamp `xml:"updateDate"`
+	Title          string     `xml:"title"`
+
+	Titles           []*Title       `xml:"titles>item"`
+	Sponsors         []*Sponsor     `xml:"sponsors>item"`
+	Cosponsors       []*Cosponsor   `xml:"cosponsors>item"`
+	Committees       []*Committee   `xml:"committees>item"`
+	CommitteeReports []string       `xml:"committeeReports>committeeReport>citation"`
+	Actions          []*Action      `xml:"actions>item"`
+	LatestAction     *ActionSummary `xml:"latestAction"`
+	RelatedBills     []*RelatedBill `xml:"relatedBills>item"`
+	PolicyArea       string         `xml:"policyArea>name"`
+	Subjects         []string       `xml:"subjects>legislativeSubjects>item>name"`
+	Summaries        []*Summary     `xml:"summaries>summary"`
+	TextVersions     []*TextVersion `xml:"textVersions>item"`
+	Laws             []*Law         `xml:"laws>item"`
+}
+
+// ID returns a compact identifier for the bill, such as "115hr1", which
+// is the form used in GovInfo package identifiers.
+func (b *Bill) ID() string {
+	return strconv.Itoa(b.Congress) + strings.ToLower(b.Type) + strconv.Itoa(b.Number)
+}
+
+// Title represents one of the titles a bill has had during its history,
+// such as its official title as introduced or its short title as enacted.
+type Title struct {
+	TitleType           string `xml:"titleType"`
+	Title               string `xml:"title"`
+	BillTextVersionName string `xml:"billTextVersionName"`
+	BillTextVersionCode string `xml:"billTextVersionCode"`
+	ChamberCode         string `xml:"chamberCode"`
+	ChamberName         string `xml:"chamberName"`
+}
+
+// Sponsor represents the member of Congress who introduced a bill.
+type Sponsor struct {
+	BioguideId  string `xml:"bioguideId"`
+	FullName    string `xml:"fullName"`
+	FirstName   string `xml:"firstName"`
+	MiddleName  string `xml:"middleName"`
+	LastName    string `xml:"lastName"`
+	Party       string `xml:"party"`
+	State       string `xml:"state"`
+	District    string `xml:"district"`
+	IsByRequest string `xml:"isByRequest"`
+}
+
+// ByRequest returns true if the sponsor introduced the bill by request,
+// rather than as an expression of their own support.
+func (s *Sponsor) ByRequest() bool {
+	return s.IsByRequest == "Y"
+}
+
+// Cosponsor represents a member of Congress who joined as a cosponsor of a
+// bill after it was introduced, or at the time of its introduction.
+type Cosponsor struct {
+	Sponsor
+	SponsorshipDate          *Date  `xml:"sponsorshipDate"`
+	SponsorshipWithdrawnDate *Date  `xml:"sponsorshipWithdrawnDate"`
+	IsOriginalCosponsor      string `xml:"isOriginalCosponsor"`
+}
+
+// Original returns true if the cosponsor joined at the time the bill was
+// introduced.
+func (c *Cosponsor) Original() bool {
+	return strings.EqualFold(c.IsOriginalCosponsor, "true")
+}
+
+// Withdrawn returns true if the cosponsor later withdrew their sponsorship.
+func (c *Cosponsor) Withdrawn() bool {
+	return c.SponsorshipWithdrawnDate != nil && !c.SponsorshipWithdrawnDate.IsZero()
+}
+
+// Committee represents a committee or subcommittee that a bill was referred
+// to, along with the activities it undertook on the bill.
+type Committee struct {
+	SystemCode    string               `xml:"systemCode"`
+	Name          string               `xml:"name"`
+	Chamber       string               `xml:"chamber"`
+	Type          string               `xml:"type"`
+	Activities    []*CommitteeActivity `xml:"activities>item"`
+	Subcommittees []*Committee         `xml:"subcommittees>item"`
+}
+
+// CommitteeActivity represents an activity of a committee on a bill, such
+// as "Referred to" or "Markup by".
+type CommitteeActivity struct {
+	Name string     `xml:"name"`
+	Date *Timestamp `xml:"date"`
+}
+
+// CommitteeRef is a reference to a committee from an action.
+type CommitteeRef struct {
+	SystemCode string `xml:"systemCode"`
+	Name       string `xml:"name"`
+}
+
+// Action represents a single action in the legislative history of a bill.
+type Action struct {
+	ActionDate    *Date           `xml:"actionDate"`
+	ActionTime    string          `xml:"actionTime"`
+	Text          string          `xml:"text"`
+	Type          string          `xml:"type"`
+	ActionCode    string          `xml:"actionCode"`
+	SourceSystem  *SourceSystem   `xml:"sourceSystem"`
+	Committees    []*CommitteeRef `xml:"committees>item"`
+	RecordedVotes []*RecordedVote `xml:"recordedVotes>recordedVote"`
+}
+
+// SourceSystem identifies the system that recorded an action, such as the
+// House floor system or the Library of Congress.
+type SourceSystem struct {
+	Code string `xml:"code"`
+	Name string `xml:"name"`
+}
+
+// RecordedVote represents a roll call vote associated with an action.
+type RecordedVote struct {
+	RollNumber    int        `xml:"rollNumber"`
+	URL           string     `xml:"url"`
+	Chamber       string     `xml:"chamber"`
+	Congress      int        `xml:"congress"`
+	Date          *Timestamp `xml:"date"`
+	SessionNumber int        `xml:"sessionNumber"`
+}
+
+// ActionSummary is an abbreviated action, used for the latest action of a
+// bill or of a related bill.
+type ActionSummary struct {
+	ActionDate *Date  `xml:"actionDate"`
+	ActionTime string `xml:"actionTime"`
+	Text       string `xml:"text"`
+}
+
+// RelatedBill represents another bill that is related to the subject bill,
+// such as an identical bill in the other chamber or the rule under which
+// the bill was considered.
+type RelatedBill struct {
+	Congress            int             `xml:"congress"`
+	Type                string          `xml:"type"`
+	Number              int             `xml:"number"`
+	Title               string          `xml:"title"`
+	LatestAction        *ActionSummary  `xml:"latestAction"`
+	RelationshipDetails []*Relationship `xml:"relationshipDetails>item"`
+}
+
+// Relationship describes one way in which a related bill relates to the
+// subject bill.
+type Relationship struct {
+	Type         string `xml:"type"`
+	IdentifiedBy string `xml:"identifiedBy"`
+}
+
+// Summary represents a summary of a bill written by the Congressional
+// Research Service for a particular version of the bill.
+//
+// Text contains HTML markup.
+type Summary struct {
+	VersionCode string     `xml:"versionCode"`
+	ActionDate  *Date      `xml:"actionDate"`
+	ActionDesc  string     `xml:"actionDesc"`
+	UpdateDate  *Timestamp `xml:"updateDate"`
+	Text        string     `xml:"text"`
+}
+
+// TextVersion represents a published text version of a bill, which can be
+// retrieved from the given URLs and parsed with package bills.
+type TextVersion struct {
+	Type string     `xml:"type"`
+	Date *Timestamp `xml:"date"`
+	URLs []string   `xml:"formats>item>url"`
+}
+
+// Law represents a law that a bill was enacted as.
+type Law struct {
+	Type   string `xml:"type"`
+	Number string `xml:"number"`
+}
@@ -0,0 +1,170 @@
+package billstatus
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/apparentlymart/go-us-law/bills"
+)
+
+const testBillStatus = `<?xml version="1.0" encoding="utf-8"?>
+<billStatus>
+<version>3.0.0</version>
+<bill>
+<number>1</number>
+<updateDate>2023-01-11T13:35:36Z</updateDate>
+<originChamber>House</originChamber>
+<type>HR</type>
+<introducedDate>2017-11-02</introducedDate>
+<congress>115</congress>
+<committees>
+<item>
+<systemCode>hswm00</systemCode>
+<name>Ways and Means Committee</name>
+<chamber>House</chamber>
+<type>Standing</type>
+<activities><item><name>Referred to</name><date>2017-11-02T14:03:10Z</date></item></activities>
+</item>
+</committees>
+<relatedBills>
+<item>
+<title>Providing for consideration of the bill (H.R. 1)</title>
+<congress>115</congress>
+<number>609</number>
+<type>HRES</type>
+<relationshipDetails><item><type>Procedurally-related</type><identifiedBy>House</identifiedBy></item></relationshipDetails>
+</item>
+</relatedBills>
+<actions>
+<item>
+<actionDate>2017-12-22</actionDate>
+<text>Became Public Law No: 115-97.</text>
+<type>BecameLaw</type>
+<actionCode>36000</actionCode>
+<sourceSystem><code>9</code><name>Library of Congress</name></sourceSystem>
+</item>
+</actions>
+<sponsors>
+<item>
+<bioguideId>B000755</bioguideId>
+<fullName>Rep. Brady, Kevin [R-TX-8]</fullName>
+<firstName>Kevin</firstName>
+<lastName>Brady</lastName>
+<party>R</party>
+<state>TX</state>
+<district>8</district>
+<isByRequest>N</isByRequest>
+</item>
+</sponsors>
+<cosponsors>
+<item>
+<bioguideId>N000181</bioguideId>
+<fullName>Rep. Nunes, Devin [R-CA-22]</fullName>
+<sponsorshipDate>2017-11-02</sponsorshipDate>
+<isOriginalCosponsor>True</isOriginalCosponsor>
+<sponsorshipWithdrawnDate></sponsorshipWithdrawnDate>
+</item>
+</cosponsors>
+<policyArea><name>Taxation</name></policyArea>
+<subjects><legislativeSubjects><item><name>Income tax rates</name></item><item><name>Tax-exempt organizations</name></item></legislativeSubjects></subjects>
+<summaries>
+<summary><versionCode>00</versionCode><actionDate>2017-11-02</actionDate><actionDesc>Introduced in House</actionDesc><text><![CDATA[<p>Tax Cuts and Jobs Act</p>]]></text></summary>
+</summaries>
+<title>An Act to provide for reconciliation</title>
+<textVersions><item><type>Enrolled Bill</type><date>2017-12-20T05:00:00Z</date><formats><item><url>https://www.govinfo.gov/content/pkg/BILLS-115hr1enr/xml/BILLS-115hr1enr.xml</url></item></formats></item></textVersions>
+<latestAction><actionDate>2017-12-22</actionDate><text>Became Public Law No: 115-97.</text></latestAction>
+<laws><item><type>Public Law</type><number>115-97</number></item></laws>
+</bill>
+</billStatus>`
+
+func TestParseBillStatus(t *testing.T) {
+	status, err := ParseBillStatusBuffer([]byte(testBillStatus))
+	if err != nil {
+		t.Fatalf("error: %s", err)
+	}
+
+	bill := status.Bill
+	if got, want := bill.ID(), "115hr1"; got != want {
+		t.Errorf("wrong ID %q; want %q", got, want)
+	}
+	if got, want := bill.IntroducedDate.Date, (bills.Date{Year: 2017, Month: time.November, Day: 2}); got != want {
+		t.Errorf("wrong IntroducedDate %#v; want %#v", got, want)
+	}
+	if got, want := bill.Committees[0].Activities[0].Date.Hour(), 14; got != want {
+		t.Errorf("wrong committee activity hour %d; want %d", got, want)
+	}
+	if got, want := bill.RelatedBills[0].RelationshipDetails[0].Type, "Procedurally-related"; got != want {
+		t.Errorf("wrong related bill relationship %q; want %q", got, want)
+	}
+	if got, want := bill.Actions[0].SourceSystem.Name, "Library of Congress"; got != want {
+		t.Errorf("wrong action source system %q; want %q", got, want)
+	}
+	if bill.Sponsors[0].ByRequest() {
+		t.Errorf("sponsor ByRequest returned true; want false")
+	}
+	if c := bill.Cosponsors[0]; !c.Original() || c.Withdrawn() {
+		t.Errorf("cosponsor Original, Withdrawn = %t, %t; want true, false", c.Original(), c.Withdrawn())
+	}
+	if got, want := len(bill.Subjects), 2; got != want {
+		t.Errorf("wrong number of subjects %d; want %d", got, want)
+	}
+	if got, want := bill.PolicyArea, "Taxation"; got != want {
+		t.Errorf("wrong PolicyArea %q; want %q", got, want)
+	}
+	if got, want := bill.Summaries[0].Text, "<p>Tax Cuts and Jobs Act</p>"; got != want {
+		t.Errorf("wrong summary text %q; want %q", got, want)
+	}
+	if got, want := bill.Laws[0].Number, "115-97"; got != want {
+		t.Errorf("wrong law number %q; want %q", got, want)
+	}
+}
+
+func TestBillStatusJSON(t *testing.T) {
+	status, err := ParseBillStatusBuffer([]byte(testBillStatus))
+	if err != nil {
+		t.Fatalf("error: %s", err)
+	}
+
+	_, err = json.Marshal(status)
+	if err != nil {
+		t.Fatalf("error encoding bill status: %s", err)
+	}
+
+	buf, err := json.Marshal(status.Bill.Cosponsors[0].SponsorshipWithdrawnDate)
+	if err != nil {
+		t.Fatalf("error encoding empty date: %s", err)
+	}
+	if got, want := string(buf), "null"; got != want {
+		t.Errorf("empty date encoded as %s; want %s", got, want)
+	}
+}
+
+func TestJoin(t *testing.T) {
+	status, err := ParseBillStatusBuffer([]byte(testBillStatus))
+	if err != nil {
+		t.Fatalf("error: %s", err)
+	}
+
+	ih := &bills.Bill{
+		Form: &bills.Form{CongressName: "115th CONGRESS", LegislationName: "H. R. 1"},
+	}
+	enr := &bills.Bill{
+		Form: &bills.Form{CongressName: "115th CONGRESS", LegislationName: "H.R.1"},
+	}
+	other := &bills.Bill{
+		Form: &bills.Form{CongressName: "115th CONGRESS", LegislationName: "H. R. 10"},
+	}
+
+	joined := Join([]*BillStatus{status}, []bills.Document{ih, other, enr})
+	if got, want := len(joined), 1; got != want {
+		t.Fatalf("wrong number of results %d; want %d", got, want)
+	}
+	if joined[0].Status != status {
+		t.Errorf("wrong status in result")
+	}
+	versions := joined[0].Versions
+	if len(versions) != 2 || versions[0] != ih || versions[1] != enr {
+		t.Errorf("wrong versions %#v; want the two versions of H.R. 1", versions)
+	}
+}
@@ -0,0 +1,80 @@
+package billstatus
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/apparentlymart/go-us-law/bills"
+)
+
+// Date is a calendar date given in an element in the ISO 8601 format
+// YYYY-MM-DD.
+//
+// An empty element is decoded as the zero value.
+type Date struct {
+	bills.Date
+}
+
+func (d *Date) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	var raw string
+	err := dec.DecodeElement(&raw, &start)
+	if err != nil {
+		return err
+	}
+
+	*d = Date{}
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil
+	}
+
+	// Some documents give dates as timestamps at midnight, so we ignore
+	// anything after the date portion.
+	if len(raw) > 10 && raw[10] == 'T' {
+		raw = raw[:10]
+	}
+
+	date, err := bills.ParseDate(raw)
+	if err != nil {
+		return fmt.Errorf("invalid %s value %q", start.Name.Local, raw)
+	}
+	d.Date = date
+	return nil
+}
+
+// IsZero returns true if the date is the zero value, as produced for an
+// empty element.
+func (d *Date) IsZero() bool {
+	return d.Year == 0 && d.Month == 0 && d.Day == 0
+}
+
+// Timestamp is a moment in time given in an element in the RFC 3339
+// format, such as "2017-11-02T14:03:10Z".
+//
+// An empty element is decoded as the zero value.
+type Timestamp struct {
+	time.Time
+}
+
+func (ts *Timestamp) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	var raw string
+	err := dec.DecodeElement(&raw, &start)
+	if err != nil {
+		return err
+	}
+
+	*ts = Timestamp{}
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil
+	}
+
+	t, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return fmt.Errorf("invalid %s value %q", start.Name.Local, raw)
+	}
+	ts.Time = t
+	return nil
+}
@@ -0,0 +1,15 @@
+// Package billstatus contains a parser and object model for the Bill Status
+// XML format published in the BILLSTATUS collection on GovInfo, which
+// describes the legislative history of a bill: its actions, sponsors and
+// cosponsors, committee referrals, related bills, subjects and summaries.
+//
+// For more information on the format, see
+// https://github.com/usgpo/bill-status
+//
+// The text of the bill itself is not included in this format; use package
+// bills to parse the text versions, and Join to associate them with their
+// status.
+//
+// The main entry points for this package are ParseBillStatus and
+// ParseBillStatusBuffer.
+package billstatus
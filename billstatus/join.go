@@ -0,0 +1,66 @@
+package billstatus
+
+import (
+	"strconv"
+
+	"github.com/apparentlymart/go-us-law/bills"
+)
+
+// Joined is a bill status together with the text versions of the same bill.
+type Joined struct {
+	Status   *BillStatus
+	Versions []bills.Document
+}
+
+// Join pairs each of the given statuses with the given text versions of the
+// same bill, matching them by congress, bill type and bill number.
+//
+// The result has one element for each given status, in the same order.
+// Text versions are associated with their statuses in the order given,
+// and any text versions that don't match any of the statuses are omitted.
+func Join(statuses []*BillStatus, versions []bills.Document) []*Joined {
+	ret := make([]*Joined, len(statuses))
+	byKey := make(map[string]*Joined, len(statuses))
+	for i, status := range statuses {
+		j := &Joined{
+			Status: status,
+		}
+		ret[i] = j
+		if status.Bill != nil {
+			byKey[status.Bill.ID()] = j
+		}
+	}
+
+	for _, doc := range versions {
+		key, ok := documentID(doc)
+		if !ok {
+			continue
+		}
+		if j, ok := byKey[key]; ok {
+			j.Versions = append(j.Versions, doc)
+		}
+	}
+
+	return ret
+}
+
+// documentID returns an identifier for the given document in the same form
+// as returned by Bill.ID, or false if the document doesn't carry enough
+// information to produce one.
+func documentID(doc bills.Document) (string, bool) {
+	form := doc.DocumentForm()
+	if form == nil {
+		return "", false
+	}
+
+	congress, err := form.Congress()
+	if err != nil {
+		return "", false
+	}
+	num, err := form.LegislationNumber()
+	if err != nil {
+		return "", false
+	}
+
+	return strconv.Itoa(congress) + num.Code(), true
+}
@@ -0,0 +1,12 @@
+// Package publaw contains a parser and object model for enacted laws, as
+// published by the Government Publishing Office in the Public Law (PLAW)
+// and Statutes at Large (STATUTE) collections.
+//
+// These documents are published in USLM format, and so the parsing is
+// delegated to package uslm. This package adds the information that is
+// specific to enacted laws, such as public law numbers, Statutes at Large
+// citations and approval dates, and a means to link a law back to the
+// bill it was enacted from.
+//
+// The main entry points for this package are ParseLaw and ParseVolume.
+package publaw
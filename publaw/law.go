@@ -0,0 +1,166 @@
+package publaw
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/apparentlymart/go-us-law/bills"
+	"github.com/apparentlymart/go-us-law/uslm"
+)
+
+// Law represents a single enacted public or private law.
+type Law struct {
+	Document *uslm.Document
+
+	// Private is true if the law is a private law, concerning only
+	// specific individuals or entities, rather than a public law.
+	Private bool
+
+	// Congress and Number together form the law number, such as 115 and 97
+	// for Public Law 115-97.
+	Congress int
+	Number   int
+
+	// StatuteCitations are the locations of the law within the Statutes at
+	// Large. A law usually has only one, but may have more if its text is
+	// split across volumes.
+	StatuteCitations []StatuteCitation
+
+	// ApprovedDate is the date the law was approved, or nil if the document
+	// does not specify it.
+	ApprovedDate *bills.Date
+
+	// LegislationName is the number of the bill or joint resolution that
+	// was enacted as the law, such as "H.R. 1", or the empty string if the
+	// document does not specify it.
+	LegislationName string
+}
+
+// ParseLaw reads a law in USLM format from the given reader.
+func ParseLaw(r io.Reader) (*Law, error) {
+	doc, err := uslm.ParseDocument(r)
+	if err != nil {
+		return nil, err
+	}
+	return NewLaw(doc)
+}
+
+// ParseLawBuffer is like ParseLaw but reads from a byte slice.
+func ParseLawBuffer(buf []byte) (*Law, error) {
+	return ParseLaw(bytes.NewReader(buf))
+}
+
+var lawNumberRe = regexp.MustCompile(`^(Public|Private)\s+Law\s+(\d+)[-–](\d+)$`)
+
+// NewLaw interprets an already-parsed USLM document as a law.
+//
+// An error is returned if the document does not have the metadata
+// required to identify the law.
+func NewLaw(doc *uslm.Document) (*Law, error) {
+	if doc.Meta == nil {
+		return nil, fmt.Errorf("document has no metadata")
+	}
+	meta := doc.Meta
+
+	law := &Law{
+		Document: doc,
+		Private:  strings.EqualFold(meta.PublicPrivate, "private"),
+	}
+
+	for _, raw := range meta.CitableAs {
+		raw = strings.TrimSpace(raw)
+		if m := lawNumberRe.FindStringSubmatch(raw); m != nil {
+			law.Private = m[1] == "Private"
+			law.Congress, _ = strconv.Atoi(m[2])
+			law.Number, _ = strconv.Atoi(m[3])
+			continue
+		}
+		if cite, err := ParseStatuteCitation(raw); err == nil {
+			law.StatuteCitations = append(law.StatuteCitations, cite)
+		}
+	}
+
+	// If there was no law number among the citations then we'll try to
+	// assemble one from the separate congress and document number.
+	if law.Congress == 0 {
+		congress, err := strconv.Atoi(strings.TrimSpace(meta.Congress))
+		if err != nil {
+			return nil, fmt.Errorf("document has invalid congress number %q", meta.Congress)
+		}
+		num := strings.TrimSpace(meta.DocNumber)
+		if i := strings.IndexAny(num, "-–"); i >= 0 {
+			num = num[i+1:]
+		}
+		number, err := strconv.Atoi(num)
+		if err != nil {
+			return nil, fmt.Errorf("document has invalid law number %q", meta.DocNumber)
+		}
+		law.Congress = congress
+		law.Number = number
+	}
+
+	if raw := strings.TrimSpace(meta.ApprovedDate); raw != "" {
+		date, err := bills.ParseDate(raw)
+		if err != nil {
+			return nil, fmt.Errorf("document has invalid approval date %q", meta.ApprovedDate)
+		}
+		law.ApprovedDate = &date
+	}
+
+	if doc.Preface != nil {
+		name := strings.TrimSpace(doc.Preface.LegislationName)
+		name = strings.TrimPrefix(name, "[")
+		name = strings.TrimSuffix(name, "]")
+		law.LegislationName = strings.TrimSpace(name)
+	}
+
+	return law, nil
+}
+
+// Body returns the enacted text of the law.
+func (l *Law) Body() bills.StructuralMarkup {
+	return l.Document.Body()
+}
+
+// String returns the conventional citation of the law, such as
+// "Public Law 115-97".
+func (l *Law) String() string {
+	kind := "Public"
+	if l.Private {
+		kind = "Private"
+	}
+	return fmt.Sprintf("%s Law %d-%d", kind, l.Congress, l.Number)
+}
+
+// IsEnactmentOf returns true if the receiver was enacted from the given
+// bill, as determined by comparing the bill's legislation number and
+// congress with those recorded in the law.
+//
+// The legislation numbers are compared with bills.ParseLegislationNumber,
+// since bills are usually numbered like "H. R. 1" while laws refer to them
+// as "H.R. 1".
+func (l *Law) IsEnactmentOf(bill *bills.Bill) bool {
+	if bill.Form == nil {
+		return false
+	}
+	billNum, err := bill.Form.LegislationNumber()
+	if err != nil {
+		return false
+	}
+	lawNum, err := l.LegislationNumber()
+	if err != nil || billNum != lawNum {
+		return false
+	}
+
+	congress, err := bill.Form.Congress()
+	return err == nil && congress == l.Congress
+}
+
+// LegislationNumber parses the law's LegislationName.
+func (l *Law) LegislationNumber() (bills.LegislationNumber, error) {
+	return bills.ParseLegislationNumber(l.LegislationName)
+}
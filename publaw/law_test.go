@@ -0,0 +1,95 @@
+package publaw
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/apparentlymart/go-us-law/bills"
+)
+
+const testLaw = `<?xml version="1.0" encoding="UTF-8"?>
+<pLaw xmlns="http://schemas.gpo.gov/xml/uslm" xmlns:dc="http://purl.org/dc/elements/1.1/">
+<meta>
+<dc:title>Public Law 115-97: An Act to provide for reconciliation</dc:title>
+<dc:type>Public Law</dc:type>
+<docNumber>97</docNumber>
+<citableAs>Public Law 115-97</citableAs>
+<citableAs>131 Stat. 2054</citableAs>
+<congress>115</congress>
+<session>1</session>
+<publicPrivate>public</publicPrivate>
+<approvedDate>2017-12-22</approvedDate>
+</meta>
+<preface>
+<page>131 STAT. 2054</page>
+<dc:type>Public Law</dc:type>
+<docNumber>115-97</docNumber>
+<dc:date>Dec. 22, 2017</dc:date>
+<legisNum>[H.R. 1]</legisNum>
+</preface>
+<main>
+<longTitle><officialTitle>An Act to provide for reconciliation.</officialTitle></longTitle>
+<section identifier="/us/pl/115/97/s1"><num value="1">SECTION 1.</num><heading>SHORT TITLE.</heading><content>This Act may be cited as the Tax Cuts and Jobs Act.</content></section>
+</main>
+</pLaw>`
+
+func TestParseLaw(t *testing.T) {
+	law, err := ParseLawBuffer([]byte(testLaw))
+	if err != nil {
+		t.Fatalf("error: %s", err)
+	}
+
+	if got, want := law.String(), "Public Law 115-97"; got != want {
+		t.Errorf("wrong law number %q; want %q", got, want)
+	}
+	wantCites := []StatuteCitation{{Volume: 131, Page: 2054}}
+	if !reflect.DeepEqual(law.StatuteCitations, wantCites) {
+		t.Errorf("wrong StatuteCitations %#v; want %#v", law.StatuteCitations, wantCites)
+	}
+	if got, want := *law.ApprovedDate, (bills.Date{Year: 2017, Month: time.December, Day: 22}); got != want {
+		t.Errorf("wrong ApprovedDate %#v; want %#v", got, want)
+	}
+	if got, want := law.LegislationName, "H.R. 1"; got != want {
+		t.Errorf("wrong LegislationName %q; want %q", got, want)
+	}
+	if got, want := len(law.Body()), 1; got != want {
+		t.Fatalf("wrong number of body elements %d; want %d", got, want)
+	}
+	if got, want := law.Body()[0].Header().Text(), "SHORT TITLE."; got != want {
+		t.Errorf("wrong section header %q; want %q", got, want)
+	}
+
+	bill := &bills.Bill{
+		Form: &bills.Form{
+			CongressName:    "115th CONGRESS",
+			LegislationName: "H. R. 1",
+		},
+	}
+	if !law.IsEnactmentOf(bill) {
+		t.Errorf("IsEnactmentOf returned false for H. R. 1; want true")
+	}
+	bill.Form.CongressName = "One Hundred Fifteenth Congress of the United States of America"
+	if !law.IsEnactmentOf(bill) {
+		t.Errorf("IsEnactmentOf returned false for the enrolled H. R. 1; want true")
+	}
+	bill.Form.CongressName = "114th CONGRESS"
+	if law.IsEnactmentOf(bill) {
+		t.Errorf("IsEnactmentOf returned true for a bill from another congress; want false")
+	}
+}
+
+func TestParseVolume(t *testing.T) {
+	input := `<statutesAtLarge><main>` + testLaw[len(`<?xml version="1.0" encoding="UTF-8"?>`):] + `</main></statutesAtLarge>`
+
+	vol, err := ParseVolumeBuffer([]byte(input))
+	if err != nil {
+		t.Fatalf("error: %s", err)
+	}
+	if got, want := len(vol.Laws), 1; got != want {
+		t.Fatalf("wrong number of laws %d; want %d", got, want)
+	}
+	if got, want := vol.Laws[0].String(), "Public Law 115-97"; got != want {
+		t.Errorf("wrong law number %q; want %q", got, want)
+	}
+}
@@ -0,0 +1,88 @@
+package publaw
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/apparentlymart/go-us-law/uslm"
+)
+
+// StatuteCitation represents a citation of a page in the Statutes at
+// Large, such as "131 Stat. 2054".
+type StatuteCitation struct {
+	Volume int
+	Page   int
+}
+
+var statuteCitationRe = regexp.MustCompile(`^(\d+)\s+Stat\.?\s+(\d+)$`)
+
+// ParseStatuteCitation parses a citation of the Statutes at Large in the
+// conventional form, such as "131 Stat. 2054".
+func ParseStatuteCitation(s string) (StatuteCitation, error) {
+	m := statuteCitationRe.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil {
+		return StatuteCitation{}, fmt.Errorf("invalid Statutes at Large citation %q", s)
+	}
+	vol, _ := strconv.Atoi(m[1])
+	page, _ := strconv.Atoi(m[2])
+	return StatuteCitation{
+		Volume: vol,
+		Page:   page,
+	}, nil
+}
+
+func (c StatuteCitation) String() string {
+	return fmt.Sprintf("%d Stat. %d", c.Volume, c.Page)
+}
+
+// Volume represents a document from the Statutes at Large collection that
+// contains several laws.
+type Volume struct {
+	Laws []*Law
+}
+
+// ParseVolume reads a Statutes at Large document from the given reader,
+// returning all of the laws it contains.
+//
+// Any "pLaw" element in the document is interpreted as a law, regardless of
+// how deeply it is nested, so this function also accepts a document
+// containing only a single law.
+func ParseVolume(r io.Reader) (*Volume, error) {
+	d := xml.NewDecoder(r)
+	vol := &Volume{}
+	for {
+		token, err := d.Token()
+		if err == io.EOF {
+			return vol, nil
+		}
+		if err != nil {
+			return vol, err
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok || start.Name.Local != "pLaw" {
+			continue
+		}
+
+		doc := &uslm.Document{}
+		err = d.DecodeElement(doc, &start)
+		if err != nil {
+			return vol, err
+		}
+		law, err := NewLaw(doc)
+		if err != nil {
+			return vol, err
+		}
+		vol.Laws = append(vol.Laws, law)
+	}
+}
+
+// ParseVolumeBuffer is like ParseVolume but reads from a byte slice.
+func ParseVolumeBuffer(buf []byte) (*Volume, error) {
+	return ParseVolume(bytes.NewReader(buf))
+}
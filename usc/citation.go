@@ -0,0 +1,132 @@
+package usc
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Citation represents a citation of a provision of the United States Code,
+// such as "42 U.S.C. 1395y(a)(1)".
+type Citation struct {
+	// Title is the number of the title, such as "42".
+	Title string
+
+	// Section is the number of the section within the title, such as
+	// "1395y". If empty, the citation refers to the whole title.
+	Section string
+
+	// Path is the sequence of enumerators of the subdivisions within the
+	// section, without their enclosing parentheses, such as
+	// []string{"a", "1"}. If empty, the citation refers to the whole
+	// section.
+	Path []string
+}
+
+var citationRe = regexp.MustCompile(`^(\d+[A-Za-z]?)\s*U\.?\s*S\.?\s*C\.?(?:\s*A\.?)?\s*(?:§+\s*)?([0-9A-Za-z][0-9A-Za-z\-–]*)?((?:\([0-9A-Za-z]+\))*)$`)
+
+var titleRe = regexp.MustCompile(`^\d+[A-Za-z]?$`)
+
+var pathRe = regexp.MustCompile(`\(([0-9A-Za-z]+)\)`)
+
+// ParseCitation parses a citation in the conventional human-readable form,
+// such as "42 U.S.C. 1395y(a)(1)". Common variations in spacing and
+// punctuation are accepted, including the use of a section symbol and
+// the "U.S.C.A." form.
+func ParseCitation(s string) (Citation, error) {
+	m := citationRe.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil {
+		return Citation{}, fmt.Errorf("invalid U.S. Code citation %q", s)
+	}
+	if m[2] == "" && m[3] != "" {
+		return Citation{}, fmt.Errorf("invalid U.S. Code citation %q: subdivisions given without section", s)
+	}
+
+	ret := Citation{
+		Title:   m[1],
+		Section: strings.Replace(m[2], "–", "-", -1),
+	}
+	for _, pm := range pathRe.FindAllStringSubmatch(m[3], -1) {
+		ret.Path = append(ret.Path, pm[1])
+	}
+	return ret, nil
+}
+
+// ParseParsableCite parses a citation in the machine-readable form used by
+// the "parsable-cite" attribute in bills, such as "usc/42/1395y". Any
+// subdivisions within the section may be given either as further path
+// segments ("usc/42/1395y/a/1") or in the conventional parenthesized form
+// ("usc/42/1395y(a)(1)").
+//
+// An error is returned for the parsable citations of other kinds of
+// document, such as "usc-chapter/42/7" or "public-law/115/97", and for
+// citations whose title is not a title number.
+func ParseParsableCite(s string) (Citation, error) {
+	raw, ok := strings.CutPrefix(strings.TrimSpace(s), "usc/")
+	if !ok {
+		return Citation{}, fmt.Errorf("invalid U.S. Code parsable citation %q: must start with \"usc/\"", s)
+	}
+	parts := strings.Split(raw, "/")
+	if !titleRe.MatchString(parts[0]) {
+		return Citation{}, fmt.Errorf("invalid U.S. Code parsable citation %q: %q is not a title number", s, parts[0])
+	}
+
+	ret := Citation{
+		Title: parts[0],
+	}
+	if len(parts) > 1 {
+		section := parts[1]
+		if i := strings.Index(section, "("); i >= 0 {
+			for _, pm := range pathRe.FindAllStringSubmatch(section[i:], -1) {
+				ret.Path = append(ret.Path, pm[1])
+			}
+			section = section[:i]
+		}
+		ret.Section = section
+		ret.Path = append(ret.Path, parts[2:]...)
+	}
+
+	for _, part := range parts {
+		if part == "" {
+			return Citation{}, fmt.Errorf("invalid U.S. Code parsable citation %q", s)
+		}
+	}
+	return ret, nil
+}
+
+// Identifier returns the USLM identifier for the cited provision, such as
+// "/us/usc/t42/s1395y/a/1".
+func (c Citation) Identifier() string {
+	var buf strings.Builder
+	buf.WriteString("/us/usc/t")
+	buf.WriteString(strings.ToLower(c.Title))
+	if c.Section == "" {
+		return buf.String()
+	}
+	buf.WriteString("/s")
+	buf.WriteString(c.Section)
+	for _, p := range c.Path {
+		buf.WriteByte('/')
+		buf.WriteString(p)
+	}
+	return buf.String()
+}
+
+// String returns the citation in the conventional human-readable form,
+// such as "42 U.S.C. 1395y(a)(1)".
+func (c Citation) String() string {
+	var buf strings.Builder
+	buf.WriteString(c.Title)
+	buf.WriteString(" U.S.C.")
+	if c.Section == "" {
+		return buf.String()
+	}
+	buf.WriteByte(' ')
+	buf.WriteString(c.Section)
+	for _, p := range c.Path {
+		buf.WriteByte('(')
+		buf.WriteString(p)
+		buf.WriteByte(')')
+	}
+	return buf.String()
+}
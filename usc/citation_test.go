@@ -0,0 +1,129 @@
+package usc
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseCitation(t *testing.T) {
+	tests := []struct {
+		Input string
+		Want  Citation
+		Err   bool
+	}{
+		{
+			"42 U.S.C. 1395y(a)(1)",
+			Citation{Title: "42", Section: "1395y", Path: []string{"a", "1"}},
+			false,
+		},
+		{
+			"42 USC 1395y",
+			Citation{Title: "42", Section: "1395y"},
+			false,
+		},
+		{
+			"42 U.S.C. § 300gg–91",
+			Citation{Title: "42", Section: "300gg-91"},
+			false,
+		},
+		{
+			"26 U.S.C.A. 1(h)",
+			Citation{Title: "26", Section: "1", Path: []string{"h"}},
+			false,
+		},
+		{
+			"5 U.S.C.",
+			Citation{Title: "5"},
+			false,
+		},
+		{
+			"42 C.F.R. 1",
+			Citation{},
+			true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Input, func(t *testing.T) {
+			got, err := ParseCitation(test.Input)
+			if test.Err {
+				if err == nil {
+					t.Fatalf("no error; want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("error: %s", err)
+			}
+			if !reflect.DeepEqual(got, test.Want) {
+				t.Errorf("wrong result\ngot:  %#v\nwant: %#v", got, test.Want)
+			}
+		})
+	}
+}
+
+func TestParseParsableCite(t *testing.T) {
+	tests := []struct {
+		Input string
+		Want  Citation
+	}{
+		{
+			"usc/42/1395y",
+			Citation{Title: "42", Section: "1395y"},
+		},
+		{
+			"usc/42/1395y/a/1",
+			Citation{Title: "42", Section: "1395y", Path: []string{"a", "1"}},
+		},
+		{
+			"usc/42/1395y(a)(1)",
+			Citation{Title: "42", Section: "1395y", Path: []string{"a", "1"}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Input, func(t *testing.T) {
+			got, err := ParseParsableCite(test.Input)
+			if err != nil {
+				t.Fatalf("error: %s", err)
+			}
+			if !reflect.DeepEqual(got, test.Want) {
+				t.Errorf("wrong result\ngot:  %#v\nwant: %#v", got, test.Want)
+			}
+		})
+	}
+}
+
+func TestParseParsableCiteErrors(t *testing.T) {
+	tests := []string{
+		"",
+		"usc/",
+		"42/1395y",
+		"usc/title/1395y",
+		"usc/42//a",
+		"usc-chapter/42/7",
+		"usc-appendix/50/1",
+		"usc-act/42/1395y",
+		"public-law/115/97",
+		"statute-at-large/131/2054",
+	}
+
+	for _, input := range tests {
+		t.Run(input, func(t *testing.T) {
+			got, err := ParseParsableCite(input)
+			if err == nil {
+				t.Errorf("no error; want error\ngot: %#v", got)
+			}
+		})
+	}
+}
+
+func TestCitationFormat(t *testing.T) {
+	c := Citation{Title: "42", Section: "1395y", Path: []string{"a", "1"}}
+	if got, want := c.String(), "42 U.S.C. 1395y(a)(1)"; got != want {
+		t.Errorf("wrong String() %q; want %q", got, want)
+	}
+	if got, want := c.Identifier(), "/us/usc/t42/s1395y/a/1"; got != want {
+		t.Errorf("wrong Identifier() %q; want %q", got, want)
+	}
+}
@@ -0,0 +1,189 @@
+package usc
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/apparentlymart/go-us-law/bills"
+	"github.com/apparentlymart/go-us-law/uslm"
+)
+
+// Title represents a single title of the United States Code, along with an
+// index of all of the levels within it that have identifiers.
+type Title struct {
+	// Number is the number of the title, such as "42".
+	Number string
+
+	Document *uslm.Document
+
+	index map[string]bills.Structural
+}
+
+// identified is implemented by the USLM level types, which are the only
+// structural elements that carry identifiers.
+type identified interface {
+	Identifier() string
+}
+
+// LoadTitle reads a title of the United States Code in USLM format from the
+// given reader and indexes it.
+func LoadTitle(r io.Reader) (*Title, error) {
+	doc, err := uslm.ParseDocument(r)
+	if err != nil {
+		return nil, err
+	}
+	return NewTitle(doc)
+}
+
+// LoadTitleFile is like LoadTitle but reads from the file at the given path.
+func LoadTitleFile(filename string) (*Title, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	t, err := LoadTitle(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", filename, err)
+	}
+	return t, nil
+}
+
+// NewTitle indexes an already-parsed USLM document as a title of the
+// United States Code.
+func NewTitle(doc *uslm.Document) (*Title, error) {
+	num := ""
+	if doc.Meta != nil {
+		num = strings.TrimSpace(doc.Meta.DocNumber)
+	}
+	if num == "" {
+		num = strings.TrimPrefix(doc.Identifier, "/us/usc/t")
+	}
+	if num == "" || strings.Contains(num, "/") {
+		return nil, fmt.Errorf("document does not identify a title of the United States Code")
+	}
+
+	t := &Title{
+		Number:   num,
+		Document: doc,
+		index:    map[string]bills.Structural{},
+	}
+	t.addToIndex(doc.Body())
+	return t, nil
+}
+
+func (t *Title) addToIndex(m bills.StructuralMarkup) {
+	for _, n := range m {
+		if in, ok := n.(identified); ok {
+			if id := in.Identifier(); id != "" {
+				// If a document contains duplicate identifiers, which can
+				// happen for sections that were enacted twice with the same
+				// number, we retain the first.
+				if _, exists := t.index[id]; !exists {
+					t.index[id] = n
+				}
+			}
+		}
+		t.addToIndex(n.ChildElements())
+	}
+}
+
+// Lookup returns the level identified by the given USLM identifier, such as
+// "/us/usc/t42/s1395y/a/1", or nil if there is no such level in the title.
+func (t *Title) Lookup(identifier string) bills.Structural {
+	return t.index[identifier]
+}
+
+// Code represents a set of loaded titles of the United States Code.
+type Code struct {
+	Titles map[string]*Title
+}
+
+// NewCode returns a Code containing the given titles.
+func NewCode(titles ...*Title) *Code {
+	c := &Code{
+		Titles: make(map[string]*Title, len(titles)),
+	}
+	for _, t := range titles {
+		c.AddTitle(t)
+	}
+	return c
+}
+
+// LoadCode loads all of the titles in the given directory, which should
+// contain files named in the same way as the OLRC's distribution, such as
+// "usc42.xml".
+func LoadCode(dir string) (*Code, error) {
+	filenames, err := filepath.Glob(filepath.Join(dir, "usc*.xml"))
+	if err != nil {
+		return nil, err
+	}
+
+	c := NewCode()
+	for _, filename := range filenames {
+		t, err := LoadTitleFile(filename)
+		if err != nil {
+			return nil, err
+		}
+		c.AddTitle(t)
+	}
+	return c, nil
+}
+
+// AddTitle adds the given title to the receiver, replacing any existing
+// title with the same number.
+func (c *Code) AddTitle(t *Title) {
+	c.Titles[strings.ToLower(t.Number)] = t
+}
+
+// Lookup returns the level of the Code identified by the given citation,
+// or nil if the cited title is not loaded or if there is no such level.
+func (c *Code) Lookup(cite Citation) bills.Structural {
+	t := c.Titles[strings.ToLower(cite.Title)]
+	if t == nil {
+		return nil
+	}
+	return t.Lookup(cite.Identifier())
+}
+
+// LookupCitation parses the given human-readable citation, such as
+// "42 U.S.C. 1395y(a)(1)", and then returns the level it identifies.
+//
+// An error is returned if the citation is not valid or if there is no
+// level matching it.
+func (c *Code) LookupCitation(s string) (bills.Structural, error) {
+	cite, err := ParseCitation(s)
+	if err != nil {
+		return nil, err
+	}
+	return c.lookupOrError(cite)
+}
+
+// ResolveParsableCite parses the given machine-readable citation, as found
+// in the ParsableCite fields of bills.QuotedBlock and
+// bills.ExternalCrossReference, and then returns the level it identifies.
+//
+// An error is returned if the citation is not valid or if there is no
+// level matching it.
+func (c *Code) ResolveParsableCite(s string) (bills.Structural, error) {
+	cite, err := ParseParsableCite(s)
+	if err != nil {
+		return nil, err
+	}
+	return c.lookupOrError(cite)
+}
+
+func (c *Code) lookupOrError(cite Citation) (bills.Structural, error) {
+	if _, ok := c.Titles[strings.ToLower(cite.Title)]; !ok {
+		return nil, fmt.Errorf("title %s of the United States Code is not loaded", cite.Title)
+	}
+	n := c.Lookup(cite)
+	if n == nil {
+		return nil, fmt.Errorf("%s does not exist", cite)
+	}
+	return n, nil
+}
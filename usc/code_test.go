@@ -0,0 +1,58 @@
+package usc
+
+import (
+	"strings"
+	"testing"
+)
+
+const testTitle = `<?xml version="1.0" encoding="UTF-8"?>
+<uscDoc xmlns="http://xml.house.gov/schemas/uslm/1.0" identifier="/us/usc/t42">
+<meta><docNumber>42</docNumber></meta>
+<main>
+<title identifier="/us/usc/t42"><num value="42">Title 42—</num><heading>THE PUBLIC HEALTH AND WELFARE</heading>
+<section identifier="/us/usc/t42/s1395y"><num value="1395y">§ 1395y.</num><heading>Exclusions from coverage</heading>
+<subsection identifier="/us/usc/t42/s1395y/a"><num value="a">(a)</num><heading>Items or services specifically excluded</heading>
+<chapeau>No payment may be made for any expenses incurred for items or services—</chapeau>
+<paragraph identifier="/us/usc/t42/s1395y/a/1"><num value="1">(1)</num><content>which are not reasonable and necessary;</content></paragraph>
+</subsection>
+</section>
+</title>
+</main>
+</uscDoc>`
+
+func TestCodeLookup(t *testing.T) {
+	title, err := LoadTitle(strings.NewReader(testTitle))
+	if err != nil {
+		t.Fatalf("error loading title: %s", err)
+	}
+	if got, want := title.Number, "42"; got != want {
+		t.Errorf("wrong title Number %q; want %q", got, want)
+	}
+
+	code := NewCode(title)
+
+	n, err := code.LookupCitation("42 U.S.C. 1395y(a)(1)")
+	if err != nil {
+		t.Fatalf("error: %s", err)
+	}
+	if got, want := n.Text().Text(), "which are not reasonable and necessary;"; got != want {
+		t.Errorf("wrong text %q; want %q", got, want)
+	}
+
+	n, err = code.ResolveParsableCite("usc/42/1395y")
+	if err != nil {
+		t.Fatalf("error: %s", err)
+	}
+	if got, want := n.Header().Text(), "Exclusions from coverage"; got != want {
+		t.Errorf("wrong header %q; want %q", got, want)
+	}
+
+	_, err = code.LookupCitation("42 U.S.C. 1395z")
+	if err == nil {
+		t.Errorf("no error for nonexistent section; want error")
+	}
+	_, err = code.LookupCitation("26 U.S.C. 1")
+	if err == nil {
+		t.Errorf("no error for title that is not loaded; want error")
+	}
+}
@@ -0,0 +1,11 @@
+// Package usc loads titles of the United States Code, as published in
+// USLM format by the Office of the Law Revision Counsel, and indexes them
+// so that individual provisions can be found by their citations.
+//
+// The titles can be obtained from http://uscode.house.gov/download/download.shtml
+//
+// This is useful in conjunction with package bills, whose QuotedBlock and
+// ExternalCrossReference types carry machine-readable citations of the
+// provisions of the Code that a bill amends; Code.ResolveParsableCite
+// accepts these citations directly.
+package usc
@@ -0,0 +1,68 @@
+package uslm
+
+import (
+	"encoding/xml"
+
+	"github.com/apparentlymart/go-us-law/bills"
+)
+
+// isBlockElement returns true if the given XML element name corresponds
+// to an element that is represented as a bills.Block when it appears in
+// the content of a level.
+func isBlockElement(name xml.Name) bool {
+	switch name.Local {
+	case "quotedContent", "table", "layout", "toc":
+		return true
+	default:
+		return false
+	}
+}
+
+func decodeBlock(d *xml.Decoder, start xml.StartElement) (bills.Block, error) {
+	switch start.Name.Local {
+	case "quotedContent":
+		ret := &bills.QuotedBlock{
+			Id: attrValue(start, "id"),
+		}
+		err := decodeQuotedContent(d, ret)
+		return ret, err
+	default:
+		ret := &bills.UnsupportedBlockElement{}
+		err := d.DecodeElement(ret, &start)
+		return ret, err
+	}
+}
+
+// decodeQuotedContent decodes the content of a USLM "quotedContent"
+// element into the given QuotedBlock, whose Content will then contain
+// bills.Structural values for any quoted levels and bills.InlineMarkup
+// values for any quoted paragraphs of text.
+func decodeQuotedContent(d *xml.Decoder, qb *bills.QuotedBlock) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+
+		switch t := token.(type) {
+		case xml.EndElement:
+			// all done!
+			return nil
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "p", "content", "chapeau", "text":
+				im, err := decodeInlineMarkup(d, t)
+				if err != nil {
+					return err
+				}
+				qb.Content = append(qb.Content, im)
+			default:
+				obj, err := decodeLevel(d, t)
+				if err != nil {
+					return err
+				}
+				qb.Content = append(qb.Content, obj)
+			}
+		}
+	}
+}
@@ -0,0 +1,20 @@
+// Package uslm contains a parser and object model for United States
+// Legislative Markup (USLM), the XML schema used by the Government
+// Publishing Office and the Office of the Law Revision Counsel to publish
+// bills, public laws and the United States Code.
+//
+// For more information on the format, see
+// https://github.com/usgpo/uslm
+//
+// USLM uses a different element vocabulary than the House bill schema
+// handled by package bills, but the object model produced by this package
+// is built from the same interfaces: hierarchical levels implement
+// bills.Structural, text content is represented as bills.InlineMarkup, and
+// so the visitors used with bills.StructuralMarkup.Walk can be reused
+// unchanged with the Body of a USLM document. Where USLM has an element
+// with the same meaning as one in the bill schema, such as "i" for italic
+// text, the corresponding type from package bills is used.
+//
+// The main entry points for this package are ParseDocument and
+// ParseDocumentBuffer.
+package uslm
@@ -0,0 +1,193 @@
+package uslm
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+
+	"github.com/apparentlymart/go-us-law/bills"
+)
+
+// Document represents a complete USLM document, such as a title of the
+// United States Code or a bill.
+type Document struct {
+	// Name is the name of the root element, which identifies the kind of
+	// document, such as "uscDoc", "bill" or "pLaw".
+	Name xml.Name
+
+	Identifier string
+
+	Meta    *Meta
+	Preface *Preface
+	Main    *Main
+}
+
+// ParseDocument reads a USLM document from the given reader.
+func ParseDocument(r io.Reader) (*Document, error) {
+	decoder := xml.NewDecoder(r)
+	var doc Document
+	err := decoder.Decode(&doc)
+	return &doc, err
+}
+
+// ParseDocumentBuffer is like ParseDocument but reads from a byte slice.
+func ParseDocumentBuffer(buf []byte) (*Document, error) {
+	return ParseDocument(bytes.NewReader(buf))
+}
+
+func (n *Document) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	*n = Document{
+		Name:       start.Name,
+		Identifier: attrValue(start, "identifier"),
+	}
+
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+
+		switch t := token.(type) {
+		case xml.EndElement:
+			// all done!
+			return nil
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "meta":
+				n.Meta = &Meta{}
+				err := d.DecodeElement(n.Meta, &t)
+				if err != nil {
+					return err
+				}
+			case "preface":
+				n.Preface = &Preface{}
+				err := d.DecodeElement(n.Preface, &t)
+				if err != nil {
+					return err
+				}
+			case "main":
+				n.Main = &Main{}
+				err := d.DecodeElement(n.Main, &t)
+				if err != nil {
+					return err
+				}
+			default:
+				err := d.Skip()
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// Body returns the hierarchical levels within the main part of the
+// document, or nil if the document has no main part.
+func (n *Document) Body() bills.StructuralMarkup {
+	if n.Main == nil {
+		return nil
+	}
+	return n.Main.Body
+}
+
+// Meta represents the metadata block at the start of a USLM document.
+//
+// Fields for elements that are not present in the document are left as
+// their zero values.
+type Meta struct {
+	Title           string   `xml:"http://purl.org/dc/elements/1.1/ title"`
+	Type            string   `xml:"http://purl.org/dc/elements/1.1/ type"`
+	Publisher       string   `xml:"http://purl.org/dc/elements/1.1/ publisher"`
+	Created         string   `xml:"http://purl.org/dc/terms/ created"`
+	DocNumber       string   `xml:"docNumber"`
+	PublicationName string   `xml:"docPublicationName"`
+	Stage           string   `xml:"docStage"`
+	Congress        string   `xml:"congress"`
+	Session         string   `xml:"session"`
+	PublicPrivate   string   `xml:"publicPrivate"`
+	ApprovedDate    string   `xml:"approvedDate"`
+	CitableAs       []string `xml:"citableAs"`
+}
+
+// Preface represents the preface of a USLM document, which contains the
+// information printed before the main text, such as the bill number and
+// the date of enactment of a public law.
+//
+// Fields for elements that are not present in the document are left as
+// their zero values.
+type Preface struct {
+	Congress         string   `xml:"congress"`
+	Session          string   `xml:"session"`
+	Type             string   `xml:"http://purl.org/dc/elements/1.1/ type"`
+	DocNumber        string   `xml:"docNumber"`
+	Date             string   `xml:"http://purl.org/dc/elements/1.1/ date"`
+	LegislationName  string   `xml:"legisNum"`
+	Pages            []string `xml:"page"`
+	EnrolledDateline string   `xml:"enrolledDateline"`
+}
+
+// Main represents the main part of a USLM document, which contains its
+// hierarchical levels.
+type Main struct {
+	LongTitle       bills.InlineMarkup
+	EnactingFormula bills.InlineMarkup
+	Body            bills.StructuralMarkup
+}
+
+func (n *Main) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	*n = Main{}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+
+		switch t := token.(type) {
+		case xml.EndElement:
+			// all done!
+			return nil
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "longTitle":
+				m, err := decodeInlineMarkup(d, t)
+				if err != nil {
+					return err
+				}
+				n.LongTitle = m
+			case "enactingFormula":
+				m, err := decodeInlineMarkup(d, t)
+				if err != nil {
+					return err
+				}
+				n.EnactingFormula = m
+			default:
+				obj, err := decodeLevel(d, t)
+				if err != nil {
+					return err
+				}
+				n.Body = append(n.Body, obj)
+			}
+		}
+	}
+}
+
+// attrValue returns the value of the non-namespaced attribute with the
+// given name on the given element, or the empty string if it is not set.
+func attrValue(start xml.StartElement, name string) string {
+	for _, attr := range start.Attr {
+		if attr.Name.Space == "" && attr.Name.Local == name {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+// attrMap returns all of the attributes of the given element as a map, as
+// used by the placeholder types for unsupported elements.
+func attrMap(start xml.StartElement) map[xml.Name]string {
+	ret := make(map[xml.Name]string, len(start.Attr))
+	for _, attr := range start.Attr {
+		ret[attr.Name] = attr.Value
+	}
+	return ret
+}
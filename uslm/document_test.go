@@ -0,0 +1,161 @@
+package uslm
+
+import (
+	"encoding/xml"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/apparentlymart/go-us-law/bills"
+)
+
+const testUSCDoc = `<?xml version="1.0" encoding="UTF-8"?>
+<uscDoc xmlns="http://xml.house.gov/schemas/uslm/1.0" xmlns:dc="http://purl.org/dc/elements/1.1/" identifier="/us/usc/t1">
+<meta>
+<dc:title>Title 1</dc:title>
+<dc:type>USCTitle</dc:type>
+<docNumber>1</docNumber>
+<docPublicationName>Online@115-90</docPublicationName>
+</meta>
+<main>
+<title identifier="/us/usc/t1"><num value="1">Title 1—</num><heading>GENERAL PROVISIONS</heading>
+<chapter identifier="/us/usc/t1/ch1"><num value="1">CHAPTER 1—</num><heading>RULES OF CONSTRUCTION</heading>
+<section identifier="/us/usc/t1/s1" id="id1"><num value="1">§ 1.</num><heading>Words denoting number, gender, and so forth</heading>
+<content><p>In determining the meaning of any Act of Congress, unless the context indicates otherwise—</p><p>words importing the <i>singular</i> include the plural;</p></content>
+<sourceCredit>(July 30, 1947, ch. 388, 61 Stat. 633.)</sourceCredit>
+</section>
+<section identifier="/us/usc/t1/s2"><num value="2">§ 2.</num><heading>“County” as including “parish”, and so forth</heading>
+<chapeau>The word “county” includes—</chapeau>
+<paragraph identifier="/us/usc/t1/s2/1"><num value="1">(1)</num><content>a parish; and</content></paragraph>
+<paragraph identifier="/us/usc/t1/s2/2"><num value="2">(2)</num><content>a <ref href="/us/usc/t1/s1">borough</ref>.</content></paragraph>
+</section>
+</chapter>
+</title>
+</main>
+</uscDoc>`
+
+// headerVisitor is a visitor written against package bills, which we use
+// here to demonstrate that such visitors can be reused with USLM documents.
+type headerVisitor struct {
+	bills.StructuralVisitorImpl
+	headers []string
+}
+
+func (v *headerVisitor) EnterStructuralElement(n bills.Structural) bills.StructuralVisitor {
+	v.headers = append(v.headers, n.Enumerator().Text()+n.Header().Text())
+	return v
+}
+
+func TestParseDocument(t *testing.T) {
+	doc, err := ParseDocument(strings.NewReader(testUSCDoc))
+	if err != nil {
+		t.Fatalf("error: %s", err)
+	}
+
+	if got, want := doc.Name.Local, "uscDoc"; got != want {
+		t.Errorf("wrong root element %q; want %q", got, want)
+	}
+	if got, want := doc.Identifier, "/us/usc/t1"; got != want {
+		t.Errorf("wrong Identifier %q; want %q", got, want)
+	}
+	if got, want := doc.Meta.Title, "Title 1"; got != want {
+		t.Errorf("wrong Meta.Title %q; want %q", got, want)
+	}
+	if got, want := doc.Meta.DocNumber, "1"; got != want {
+		t.Errorf("wrong Meta.DocNumber %q; want %q", got, want)
+	}
+
+	v := &headerVisitor{}
+	doc.Body().Walk(v)
+	wantHeaders := []string{
+		"Title 1—GENERAL PROVISIONS",
+		"CHAPTER 1—RULES OF CONSTRUCTION",
+		"§ 1.Words denoting number, gender, and so forth",
+		"§ 2.“County” as including “parish”, and so forth",
+		"(1)",
+		"(2)",
+	}
+	if !reflect.DeepEqual(v.headers, wantHeaders) {
+		t.Errorf("wrong headers\ngot:  %#v\nwant: %#v", v.headers, wantHeaders)
+	}
+
+	chapter := doc.Body()[0].ChildElements()[0]
+	s1, ok := chapter.ChildElements()[0].(*Section)
+	if !ok {
+		t.Fatalf("first chapter child is %T; want *Section", chapter.ChildElements()[0])
+	}
+	if got, want := s1.Identifier(), "/us/usc/t1/s1"; got != want {
+		t.Errorf("wrong section Identifier %q; want %q", got, want)
+	}
+	if got, want := s1.NumValue(), "1"; got != want {
+		t.Errorf("wrong section NumValue %q; want %q", got, want)
+	}
+	if got, want := s1.ID(), "id1"; got != want {
+		t.Errorf("wrong section ID %q; want %q", got, want)
+	}
+	if got, want := s1.SourceCredit().Text(), "(July 30, 1947, ch. 388, 61 Stat. 633.)"; got != want {
+		t.Errorf("wrong section SourceCredit %q; want %q", got, want)
+	}
+
+	text := s1.Text()
+	if got, want := len(text), 2; got != want {
+		t.Fatalf("wrong number of content nodes %d; want %d", got, want)
+	}
+	p, ok := text[1].(*P)
+	if !ok {
+		t.Fatalf("second content node is %T; want *P", text[1])
+	}
+	if _, ok := p.InlineMarkup[1].(*bills.Italic); !ok {
+		t.Errorf("emphasized node is %T; want *bills.Italic", p.InlineMarkup[1])
+	}
+
+	s2 := chapter.ChildElements()[1]
+	if got, want := s2.Text().Text(), "The word “county” includes—"; got != want {
+		t.Errorf("wrong chapeau %q; want %q", got, want)
+	}
+	ref, ok := s2.ChildElements()[1].Text()[1].(*Ref)
+	if !ok {
+		t.Fatalf("reference node is %T; want *Ref", s2.ChildElements()[1].Text()[1])
+	}
+	if got, want := ref.Href, "/us/usc/t1/s1"; got != want {
+		t.Errorf("wrong Href %q; want %q", got, want)
+	}
+}
+
+func TestLevelProvisos(t *testing.T) {
+	input := `<section identifier="/us/usc/t2/s1"><num value="1">§ 1.</num><chapeau>For salaries—</chapeau>
+<paragraph identifier="/us/usc/t2/s1/1"><num value="1">(1)</num><content>$1,000; and</content></paragraph>
+<continuation>to remain available until expended.</continuation>
+<paragraph identifier="/us/usc/t2/s1/2"><num value="2">(2)</num><content>$2,000:</content></paragraph>
+<proviso><i>Provided</i>, That none shall be paid in advance:</proviso>
+<proviso><i>Provided further</i>, That this section expires in 2019.</proviso>
+</section>`
+
+	var section Section
+	err := xml.Unmarshal([]byte(input), &section)
+	if err != nil {
+		t.Fatalf("error: %s", err)
+	}
+
+	type summary struct {
+		Proviso  bool
+		Position int
+		Text     string
+	}
+	var got []summary
+	for _, c := range section.Continuations() {
+		got = append(got, summary{c.Proviso, c.Position, c.Text.Text()})
+	}
+	want := []summary{
+		{false, 1, "to remain available until expended."},
+		{true, 2, "Provided, That none shall be paid in advance:"},
+		{true, 2, "Provided further, That this section expires in 2019."},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("wrong continuations\ngot:  %#v\nwant: %#v", got, want)
+	}
+
+	if got, want := section.ContinuationText().Text(), "Provided, That none shall be paid in advance:"; got != want {
+		t.Errorf("wrong continuation text %q; want %q", got, want)
+	}
+}
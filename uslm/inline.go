@@ -0,0 +1,160 @@
+package uslm
+
+import (
+	"encoding/xml"
+
+	"github.com/apparentlymart/go-us-law/bills"
+)
+
+// decodeInlineMarkup decodes the content of the given element as inline
+// markup, consuming tokens up to and including its end element.
+func decodeInlineMarkup(d *xml.Decoder, start xml.StartElement) (bills.InlineMarkup, error) {
+	ret := make(bills.InlineMarkup, 0, 1)
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return ret, err
+		}
+
+		switch t := token.(type) {
+		case xml.EndElement:
+			// all done!
+			return ret, nil
+		case xml.StartElement:
+			obj, err := decodeInline(d, t)
+			if err != nil {
+				return ret, err
+			}
+			ret = append(ret, obj)
+		case xml.CharData:
+			ret = append(ret, bills.Text(t))
+		}
+	}
+}
+
+// decodeContent is like decodeInlineMarkup except that it separates any
+// block elements in the content into a separate BlockMarkup.
+//
+// This is used for the "chapeau" and "content" elements, which can contain
+// quoted content and tables in addition to text.
+func decodeContent(d *xml.Decoder, start xml.StartElement) (bills.InlineMarkup, bills.BlockMarkup, error) {
+	im := make(bills.InlineMarkup, 0, 1)
+	var blocks bills.BlockMarkup
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return im, blocks, err
+		}
+
+		switch t := token.(type) {
+		case xml.EndElement:
+			// all done!
+			return im, blocks, nil
+		case xml.StartElement:
+			if isBlockElement(t.Name) {
+				obj, err := decodeBlock(d, t)
+				if err != nil {
+					return im, blocks, err
+				}
+				blocks = append(blocks, obj)
+				continue
+			}
+
+			obj, err := decodeInline(d, t)
+			if err != nil {
+				return im, blocks, err
+			}
+			im = append(im, obj)
+		case xml.CharData:
+			im = append(im, bills.Text(t))
+		}
+	}
+}
+
+func decodeInline(d *xml.Decoder, start xml.StartElement) (bills.Inline, error) {
+	// Leaf elements first, since they have no content to decode.
+	switch start.Name.Local {
+	case "br":
+		return &bills.LineBreak{}, d.Skip()
+	case "footnoteRef":
+		return &bills.FootnoteRef{IdRef: attrValue(start, "idref")}, d.Skip()
+	}
+
+	content, err := decodeInlineMarkup(d, start)
+	if err != nil {
+		return nil, err
+	}
+
+	switch start.Name.Local {
+	case "b":
+		return &bills.Bold{InlineMarkup: content}, nil
+	case "def":
+		return &bills.Definition{InlineMarkup: content}, nil
+	case "del":
+		return &bills.DeletedPhrase{InlineMarkup: content}, nil
+	case "i":
+		return &bills.Italic{InlineMarkup: content}, nil
+	case "ins":
+		return &bills.AddedPhrase{InlineMarkup: content}, nil
+	case "quotedText":
+		return &bills.InlineQuote{InlineMarkup: content}, nil
+	case "shortTitle":
+		return &bills.ShortTitle{InlineMarkup: content}, nil
+	case "sub":
+		return &bills.Subscript{InlineMarkup: content}, nil
+	case "sup":
+		return &bills.Superscript{InlineMarkup: content}, nil
+	case "term":
+		return &bills.Term{InlineMarkup: content}, nil
+	case "date":
+		return &Date{
+			InlineMarkup: content,
+			Date:         attrValue(start, "date"),
+		}, nil
+	case "p":
+		return &P{InlineMarkup: content}, nil
+	case "ref":
+		return &Ref{
+			InlineMarkup: content,
+			Href:         attrValue(start, "href"),
+			IdRef:        attrValue(start, "idref"),
+		}, nil
+	default:
+		return &bills.UnsupportedInlineElement{
+			Name:         start.Name,
+			Attrs:        attrMap(start),
+			InlineMarkup: content,
+		}, nil
+	}
+}
+
+// Ref represents a reference to another document or to another part of the
+// same document.
+type Ref struct {
+	bills.InlineMarkup
+
+	// Href is a reference to another document, in the form of a path such
+	// as "/us/usc/t42/s1395y/a/1".
+	Href string
+
+	// IdRef is a reference to an element with the given id within the same
+	// document.
+	IdRef string
+}
+
+// Date represents a date mentioned in the text.
+type Date struct {
+	bills.InlineMarkup
+
+	// Date is the date in the ISO 8601 format YYYY-MM-DD.
+	Date string
+}
+
+// P represents a paragraph of text within the content of a level.
+//
+// USLM content can contain several paragraphs of unnumbered text, which
+// are represented as inline elements so that the content of a level can
+// be represented as a single bills.InlineMarkup.
+type P struct {
+	bills.InlineMarkup
+}
@@ -0,0 +1,398 @@
+package uslm
+
+import (
+	"encoding/xml"
+
+	"github.com/apparentlymart/go-us-law/bills"
+)
+
+func decodeLevel(d *xml.Decoder, start xml.StartElement) (bills.Structural, error) {
+	switch start.Name.Local {
+	case "article":
+		ret := &Article{}
+		err := d.DecodeElement(ret, &start)
+		return ret, err
+	case "chapter":
+		ret := &Chapter{}
+		err := d.DecodeElement(ret, &start)
+		return ret, err
+	case "clause":
+		ret := &Clause{}
+		err := d.DecodeElement(ret, &start)
+		return ret, err
+	case "division":
+		ret := &Division{}
+		err := d.DecodeElement(ret, &start)
+		return ret, err
+	case "item":
+		ret := &Item{}
+		err := d.DecodeElement(ret, &start)
+		return ret, err
+	case "level":
+		ret := &Level{}
+		err := d.DecodeElement(ret, &start)
+		return ret, err
+	case "paragraph":
+		ret := &Paragraph{}
+		err := d.DecodeElement(ret, &start)
+		return ret, err
+	case "part":
+		ret := &Part{}
+		err := d.DecodeElement(ret, &start)
+		return ret, err
+	case "section":
+		ret := &Section{}
+		err := d.DecodeElement(ret, &start)
+		return ret, err
+	case "subchapter":
+		ret := &Subchapter{}
+		err := d.DecodeElement(ret, &start)
+		return ret, err
+	case "subclause":
+		ret := &Subclause{}
+		err := d.DecodeElement(ret, &start)
+		return ret, err
+	case "subdivision":
+		ret := &Subdivision{}
+		err := d.DecodeElement(ret, &start)
+		return ret, err
+	case "subitem":
+		ret := &Subitem{}
+		err := d.DecodeElement(ret, &start)
+		return ret, err
+	case "subparagraph":
+		ret := &Subparagraph{}
+		err := d.DecodeElement(ret, &start)
+		return ret, err
+	case "subpart":
+		ret := &Subpart{}
+		err := d.DecodeElement(ret, &start)
+		return ret, err
+	case "subsection":
+		ret := &Subsection{}
+		err := d.DecodeElement(ret, &start)
+		return ret, err
+	case "subsubitem":
+		ret := &Subsubitem{}
+		err := d.DecodeElement(ret, &start)
+		return ret, err
+	case "subtitle":
+		ret := &Subtitle{}
+		err := d.DecodeElement(ret, &start)
+		return ret, err
+	case "title":
+		ret := &Title{}
+		err := d.DecodeElement(ret, &start)
+		return ret, err
+	default:
+		ret := &UnsupportedLevel{}
+		err := d.DecodeElement(ret, &start)
+		return ret, err
+	}
+}
+
+// LevelElement is embedded into each of the types representing the
+// hierarchical levels of a USLM document, and provides their
+// implementation of bills.Structural.
+//
+// The USLM "num", "heading", "chapeau" (or "content") and "continuation"
+// elements correspond to the enumerator, header, text and continuation
+// text of a structural element in the bill schema. A USLM level may have
+// any number of "continuation" and "proviso" elements, interleaved with its
+// child levels, so these are also available separately from Continuations.
+type LevelElement struct {
+	id            string
+	identifier    string
+	numValue      string
+	status        string
+	num           bills.InlineMarkup
+	heading       bills.InlineMarkup
+	text          bills.InlineMarkup
+	blocks        bills.BlockMarkup
+	children      bills.StructuralMarkup
+	continuations []Continuation
+	sourceCredit  bills.InlineMarkup
+}
+
+// Continuation represents a "continuation" or "proviso" element within a
+// level, which continues the level's text after its chapeau or content.
+type Continuation struct {
+	// Proviso is true for a "proviso" element and false for a
+	// "continuation" element.
+	Proviso bool
+
+	// Position is the number of the level's child levels that precede the
+	// element.
+	Position int
+
+	Text bills.InlineMarkup
+}
+
+func (m *LevelElement) ID() string {
+	return m.id
+}
+
+// Identifier returns the value of the level's "identifier" attribute, which
+// is a path such as "/us/usc/t42/s1395y/a/1" that uniquely identifies the
+// level within the body of law it belongs to.
+func (m *LevelElement) Identifier() string {
+	return m.identifier
+}
+
+// NumValue returns the normalized value of the level's number, such as
+// "1395y" for a section numbered "§ 1395y.", or the empty string if the
+// level has no number.
+func (m *LevelElement) NumValue() string {
+	return m.numValue
+}
+
+// Status returns the value of the level's "status" attribute, such as
+// "repealed" or "transferred", or the empty string if the level is in
+// effect.
+func (m *LevelElement) Status() string {
+	return m.status
+}
+
+// SectionType always returns the empty string, because USLM has no
+// equivalent of the bill schema's "section-type" attribute.
+func (m *LevelElement) SectionType() string {
+	return ""
+}
+
+// DisplayInline always returns false, because USLM has no equivalent of the
+// bill schema's "display-inline" attribute.
+func (m *LevelElement) DisplayInline() bool {
+	return false
+}
+
+// Commented always returns false, because USLM has no equivalent of the
+// bill schema's "commented" attribute.
+func (m *LevelElement) Commented() bool {
+	return false
+}
+
+// ReportedDisplayStyle always returns the empty string, because USLM has no
+// equivalent of the bill schema's "reported-display-style" attribute.
+func (m *LevelElement) ReportedDisplayStyle() string {
+	return ""
+}
+
+func (m *LevelElement) Enumerator() bills.InlineMarkup {
+	return m.num
+}
+
+func (m *LevelElement) Header() bills.InlineMarkup {
+	return m.heading
+}
+
+// Text returns the level's chapeau, if it has child levels, or its content
+// otherwise.
+func (m *LevelElement) Text() bills.InlineMarkup {
+	return m.text
+}
+
+func (m *LevelElement) Blocks() bills.BlockMarkup {
+	return m.blocks
+}
+
+func (m *LevelElement) ChildElements() bills.StructuralMarkup {
+	return m.children
+}
+
+// ContinuationText returns the text of the first continuation or proviso
+// that follows all of the level's child levels, which is the position of
+// the continuation text in the bill schema, or nil if there is none.
+//
+// Use Continuations to access all of the continuations and provisos.
+func (m *LevelElement) ContinuationText() bills.InlineMarkup {
+	for _, c := range m.continuations {
+		if c.Position == len(m.children) {
+			return c.Text
+		}
+	}
+	return nil
+}
+
+// Continuations returns the level's continuations and provisos in document
+// order.
+func (m *LevelElement) Continuations() []Continuation {
+	return m.continuations
+}
+
+// SourceCredit returns the level's source credit, which cites the
+// enactments the level's text derives from, or nil if it has none.
+func (m *LevelElement) SourceCredit() bills.InlineMarkup {
+	return m.sourceCredit
+}
+
+func (m *LevelElement) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	*m = LevelElement{
+		id:         attrValue(start, "id"),
+		identifier: attrValue(start, "identifier"),
+		status:     attrValue(start, "status"),
+	}
+
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+
+		switch t := token.(type) {
+		case xml.EndElement:
+			// all done!
+			return nil
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "num":
+				m.numValue = attrValue(t, "value")
+				im, err := decodeInlineMarkup(d, t)
+				if err != nil {
+					return err
+				}
+				m.num = im
+			case "heading":
+				im, err := decodeInlineMarkup(d, t)
+				if err != nil {
+					return err
+				}
+				m.heading = im
+			case "chapeau", "content", "text":
+				im, blocks, err := decodeContent(d, t)
+				if err != nil {
+					return err
+				}
+				m.text = append(m.text, im...)
+				m.blocks = append(m.blocks, blocks...)
+			case "continuation", "proviso":
+				im, err := decodeInlineMarkup(d, t)
+				if err != nil {
+					return err
+				}
+				m.continuations = append(m.continuations, Continuation{
+					Proviso:  t.Name.Local == "proviso",
+					Position: len(m.children),
+					Text:     im,
+				})
+			case "sourceCredit":
+				im, err := decodeInlineMarkup(d, t)
+				if err != nil {
+					return err
+				}
+				m.sourceCredit = im
+			case "notes", "toc":
+				// Editorial notes and tables of contents are not yet
+				// represented in this model.
+				err := d.Skip()
+				if err != nil {
+					return err
+				}
+			default:
+				obj, err := decodeLevel(d, t)
+				if err != nil {
+					return err
+				}
+				m.children = append(m.children, obj)
+			}
+		}
+	}
+}
+
+type Article struct {
+	LevelElement
+}
+
+type Chapter struct {
+	LevelElement
+}
+
+type Clause struct {
+	LevelElement
+}
+
+type Division struct {
+	LevelElement
+}
+
+type Item struct {
+	LevelElement
+}
+
+// Level represents the generic USLM "level" element, which is used for
+// hierarchical levels that don't correspond to any of the more specific
+// level types.
+type Level struct {
+	LevelElement
+}
+
+type Paragraph struct {
+	LevelElement
+}
+
+type Part struct {
+	LevelElement
+}
+
+type Section struct {
+	LevelElement
+}
+
+type Subchapter struct {
+	LevelElement
+}
+
+type Subclause struct {
+	LevelElement
+}
+
+type Subdivision struct {
+	LevelElement
+}
+
+type Subitem struct {
+	LevelElement
+}
+
+type Subparagraph struct {
+	LevelElement
+}
+
+type Subpart struct {
+	LevelElement
+}
+
+type Subsection struct {
+	LevelElement
+}
+
+type Subsubitem struct {
+	LevelElement
+}
+
+type Subtitle struct {
+	LevelElement
+}
+
+type Title struct {
+	LevelElement
+}
+
+// UnsupportedLevel is a placeholder node type for elements in level
+// positions that we don't yet support.
+//
+// Callers should ignore nodes of this type except to walk to the children
+// when traversing the graph; future versions of this package may start
+// to support the given element, which would be a breaking change for any
+// caller that specifically depends on recieving UnsupportedLevel
+// instances.
+type UnsupportedLevel struct {
+	Name  xml.Name
+	Attrs map[xml.Name]string
+	LevelElement
+}
+
+func (n *UnsupportedLevel) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	n.Name = start.Name
+	n.Attrs = attrMap(start)
+	return n.LevelElement.UnmarshalXML(d, start)
+}